@@ -0,0 +1,213 @@
+package grhyph
+
+import (
+	_ "embed"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Accentuation is the accent restoration of a word.
+type Accentuation struct {
+	Word string `json:"word"`
+
+	// Accented is the most frequent of the Candidates, or Word when the lexicon does not know it. Greeklish
+	// words are transliterated to Greek, see ToGreek, whether known or not.
+	Accented string `json:"accented"`
+
+	// Candidates are the forms of the lexicon that agree with the diacritics of Word, the most frequent first.
+	// More than one candidate makes the word ambiguous, e.g. πότε and ποτέ.
+	Candidates []string `json:"candidates,omitempty"`
+}
+
+//go:embed grhyph.accents
+var grhyphAccentsFile string
+
+// accentLexicon maps the unaccented spellings of the words of grhyph.accents to their accented forms, in the
+// order they first occur, and greeklishAccentLexicon maps their greeklishKey.
+var accentLexicon, greeklishAccentLexicon = loadAccentLexicon(grhyphAccentsFile)
+
+func loadAccentLexicon(file string) (map[string][]string, map[string][]string) {
+	lexicon, greeklish := map[string][]string{}, map[string][]string{}
+
+	for _, line := range strings.Split(file, "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		if i := strings.Index(line, " #"); i >= 0 {
+			line = line[:i]
+		}
+
+		for _, word := range strings.Fields(line) {
+			key := stripAccents(word)
+			lexicon[key] = appendCandidate(lexicon[key], word)
+			greeklish[greeklishKey(key)] = appendCandidate(greeklish[greeklishKey(key)], word)
+		}
+	}
+
+	return lexicon, greeklish
+}
+
+// stripAccents lowercases s, and drops the accents and diaeresis of its Greek vowels. A final σ, e.g. of a word
+// in uppercase, is spelled ς.
+func stripAccents(s string) string {
+	s = strings.Map(func(r rune) rune {
+		r = unicode.ToLower(r)
+		if accent, ok := greekAccents[r]; ok {
+			return accent.letter
+		}
+
+		return r
+	}, s)
+
+	if strings.HasSuffix(s, "σ") {
+		s = strings.TrimSuffix(s, "σ") + "ς"
+	}
+
+	return s
+}
+
+// greeklishKeyReplacer folds the letters that Greeklish spells alike, e.g. ι, η, υ, ει and οι as "i". "ου" is
+// replaced by itself, so that its υ is kept.
+var greeklishKeyReplacer = strings.NewReplacer(
+	"ου", "ου", "ει", "ι", "οι", "ι", "υι", "ι", "αι", "ε", "αυ", "αβ", "ευ", "εβ", "αφ", "αβ", "εφ", "εβ",
+	"η", "ι", "υ", "ι", "ω", "ο", "ς", "σ",
+)
+
+// greeklishKey folds an unaccented Greek word, as transliterated from Greeklish, to the letters that its
+// Greeklish spellings have in common.
+func greeklishKey(s string) string {
+	return greeklishKeyReplacer.Replace(s)
+}
+
+// RestoreAccents restores the accents and diaeresis of a Greek or Greeklish word from the embedded lexicon
+// of grhyph.accents. The accents that word already has narrow the candidates down. The case of word is kept,
+// except that words in uppercase take no accents, but their diaeresis.
+func RestoreAccents(word string) Accentuation {
+	a := Accentuation{Word: word, Accented: word}
+
+	if strings.IndexFunc(word, isLatinLetter) >= 0 {
+		a.Accented = ToGreek(word, GreeklishOptions{})
+		for _, candidate := range greeklishAccentLexicon[greeklishKey(stripAccents(a.Accented))] {
+			a.Candidates = appendCandidate(a.Candidates, capitalizeAs(candidate, word))
+		}
+	} else {
+		for _, candidate := range accentLexicon[stripAccents(word)] {
+			if agreesWithAccents(candidate, word) {
+				a.Candidates = appendCandidate(a.Candidates, matchAccentedCase(candidate, word))
+			}
+		}
+	}
+
+	if len(a.Candidates) > 0 {
+		a.Accented = a.Candidates[0]
+	}
+
+	return a
+}
+
+// AccentText restores the accents of the words of text, as RestoreAccents does, with their most frequent
+// candidates.
+func AccentText(text string) string {
+	return accentWords(text, true)
+}
+
+// accentWords restores the accents of the Greek words of text, and of its Greeklish words if greeklish is set.
+func accentWords(text string, greeklish bool) string {
+	var (
+		b     strings.Builder
+		start = -1
+	)
+
+	writeWord := func(word string) {
+		if greeklish || strings.IndexFunc(word, isLatinLetter) < 0 {
+			word = RestoreAccents(word).Accented
+		}
+		b.WriteString(word)
+		start = -1
+	}
+
+	for i, r := range text {
+		if !isWordBoundary(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+
+		if start >= 0 {
+			writeWord(text[start:i])
+		}
+		b.WriteRune(r)
+	}
+
+	if start >= 0 {
+		writeWord(text[start:])
+	}
+
+	return b.String()
+}
+
+func isLatinLetter(r rune) bool {
+	return r < utf8.RuneSelf && unicode.IsLetter(r)
+}
+
+func appendCandidate(candidates []string, candidate string) []string {
+	for _, c := range candidates {
+		if c == candidate {
+			return candidates
+		}
+	}
+
+	return append(candidates, candidate)
+}
+
+// agreesWithAccents tells whether the lowercase candidate has every accent and diaeresis of word, which are
+// spelled alike but for them.
+func agreesWithAccents(candidate, word string) bool {
+	candidateRunes := []rune(candidate)
+	for i, r := range []rune(word) {
+		if _, ok := greekAccents[unicode.ToLower(r)]; ok && unicode.ToLower(r) != candidateRunes[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchAccentedCase capitalizes the lowercase candidate as word, which is spelled alike but for its accents.
+// Words in uppercase keep their diaeresis only.
+func matchAccentedCase(candidate, word string) string {
+	runes, wordRunes := []rune(candidate), []rune(word)
+	upper := len(wordRunes) > 1 && strings.IndexFunc(word, unicode.IsLower) < 0
+
+	for i, r := range runes {
+		if !unicode.IsUpper(wordRunes[i]) {
+			continue
+		}
+
+		if upper {
+			r = dropAcute(r)
+		}
+		runes[i] = unicode.ToUpper(r)
+	}
+
+	return string(runes)
+}
+
+// capitalizeAs capitalizes the lowercase Greek candidate as the Greeklish word: all of it, without accents,
+// if word is in uppercase, or its first letter.
+func capitalizeAs(candidate, word string) string {
+	first, _ := utf8.DecodeRuneInString(word)
+	if !unicode.IsUpper(first) {
+		return candidate
+	}
+
+	if utf8.RuneCountInString(word) > 1 && strings.IndexFunc(word, unicode.IsLower) < 0 {
+		return matchAccentedCase(candidate, strings.ToUpper(stripAccents(candidate)))
+	}
+
+	r, size := utf8.DecodeRuneInString(candidate)
+
+	return string(unicode.ToUpper(r)) + candidate[size:]
+}
@@ -0,0 +1,177 @@
+package grhyph
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func TestRestoreAccents(t *testing.T) {
+	tests := []Accentuation{
+		{"ποτε", "πότε", []string{"πότε", "ποτέ"}},
+		{"Ποτε", "Πότε", []string{"Πότε", "Ποτέ"}},
+		{"ποτέ", "ποτέ", []string{"ποτέ"}},
+		{"προιον", "προϊόν", []string{"προϊόν"}},
+		{"ΠΡΟΙΟΝ", "ΠΡΟΪΟΝ", []string{"ΠΡΟΪΟΝ"}},
+		{"ΑΝΘΡΩΠΟΣ", "ΑΝΘΡΩΠΟΣ", []string{"ΑΝΘΡΩΠΟΣ"}},
+		{"Ανθρωποσ", "Άνθρωπος", []string{"Άνθρωπος"}},
+		{"Ωρα", "Ώρα", []string{"Ώρα"}},
+		{"κουκουβαγια", "κουκουβαγια", nil},
+		{"Kalimera", "Καλημέρα", []string{"Καλημέρα"}},
+		{"xronia", "χρόνια", []string{"χρόνια", "χρονιά"}},
+		{"efxaristw", "ευχαριστώ", []string{"ευχαριστώ", "ευχάριστο"}},
+		{"koukouvagia", "κουκουβαγια", nil},
+	}
+
+	for _, test := range tests {
+		if a := RestoreAccents(test.Word); !reflect.DeepEqual(a, test) {
+			t.Errorf("(%s) Accentuation does not match: expected %+v, got %+v", test.Word, test, a)
+		}
+	}
+}
+
+func TestAccentText(t *testing.T) {
+	tests := []struct{ input, expected string }{
+		{"Καλημερα, ποτε θα ερθεις στη Θεσσαλονικη;", "Καλημέρα, πότε θα έρθεις στη Θεσσαλονίκη;"},
+		{"xronia polla", "χρόνια πολλά"},
+	}
+
+	for _, test := range tests {
+		if accented := AccentText(test.input); accented != test.expected {
+			t.Errorf("(%s) Accented value does not match: expected %s, got %s", test.input, test.expected, accented)
+		}
+	}
+}
+
+// TestAccentLexicon checks that the forms of grhyph.accents are accented as Stress and CheckMonosyllables
+// require, and that the first line to list a form of an ambiguous word lists all of them, in order.
+func TestAccentLexicon(t *testing.T) {
+	listed := map[string]bool{}
+
+	for _, line := range strings.Split(grhyphAccentsFile, "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		if i := strings.Index(line, " #"); i >= 0 {
+			line = line[:i]
+		}
+
+		words := strings.Fields(line)
+		for _, word := range words {
+			if word != strings.ToLower(word) {
+				t.Errorf("(%s) Word is not in lowercase", word)
+			}
+			switch stress, monosyllables := Stress(word), CheckMonosyllables(word, MonosyllableOptions{}); {
+			case len(stress.Errors) > 0:
+				t.Errorf("(%s) Stress errors do not match: expected none, got %v", word, stress.Errors)
+			case len(monosyllables) > 0:
+				t.Errorf("(%s) Monosyllable errors do not match: expected none, got %+v", word, monosyllables)
+			}
+
+			key := stripAccents(word)
+			if listed[key] {
+				continue
+			}
+			listed[key] = true
+
+			var candidates []string
+			for _, w := range words {
+				if stripAccents(w) == key {
+					candidates = appendCandidate(candidates, w)
+				}
+			}
+			if !reflect.DeepEqual(candidates, accentLexicon[key]) {
+				t.Errorf("(%s) Candidates of its first line do not match: expected %v, got %v", key,
+					accentLexicon[key], candidates)
+			}
+		}
+	}
+}
+
+// accentTextSamples are everyday, news and narrative prose, on which TestAccentTextSamples runs AccentText.
+var accentTextSamples = []string{
+	"Κάθε πρωί ξυπνάω νωρίς, πίνω έναν καφέ στο μπαλκόνι και διαβάζω τις ειδήσεις στο κινητό. Ύστερα " +
+		"ντύνομαι και πηγαίνω στη δουλειά με το λεωφορείο, γιατί το αυτοκίνητο είναι πάντα χαλασμένο και δεν " +
+		"βρίσκω ποτέ θέση για να παρκάρω. Το μεσημέρι τρώμε με τους συναδέλφους σε μια μικρή ταβέρνα κοντά " +
+		"στο γραφείο, όπου το φαγητό είναι νόστιμο και φτηνό. Το απόγευμα γυρίζω σπίτι κουρασμένος, αλλά αν " +
+		"έχει καλό καιρό βγαίνω για περπάτημα στο πάρκο ή τηλεφωνώ σε έναν φίλο να πάμε για ένα ποτό. Τα " +
+		"Σάββατα πηγαίνουμε με τα παιδιά στη θάλασσα, κολυμπάμε, παίζουμε στην άμμο και γυρίζουμε το βράδυ " +
+		"χαρούμενοι.",
+	"Η κυβέρνηση ανακοίνωσε χθες νέα μέτρα για τη στήριξη των νοικοκυριών, καθώς οι τιμές της ενέργειας " +
+		"συνεχίζουν να αυξάνονται. Σύμφωνα με τον υπουργό Οικονομικών, τα μέτρα θα εφαρμοστούν από τον " +
+		"επόμενο μήνα και θα καλύψουν περισσότερες από ένα εκατομμύριο οικογένειες. Η αντιπολίτευση " +
+		"υποστηρίζει ότι η βοήθεια δεν είναι αρκετή και ζητά μεγαλύτερη μείωση των φόρων. Παράλληλα, οι " +
+		"εργαζόμενοι στα νοσοκομεία πραγματοποιούν σήμερα απεργία, ζητώντας αυξήσεις στους μισθούς και " +
+		"προσλήψεις προσωπικού. Σύμφωνα με τις τελευταίες δημοσκοπήσεις, το ζήτημα της ακρίβειας αποτελεί " +
+		"την πρώτη ανησυχία των πολιτών.",
+	"Όταν ήμουν μικρός, περνούσα τα καλοκαίρια στο χωριό της γιαγιάς μου. Το σπίτι της ήταν παλιό, με μια " +
+		"μεγάλη αυλή γεμάτη λουλούδια και ένα δέντρο που έδινε δροσιά τις ζεστές μέρες. Κάθε απόγευμα " +
+		"καθόμασταν κάτω από το δέντρο και εκείνη μας έλεγε ιστορίες για τον πόλεμο και για τους ανθρώπους " +
+		"που έφυγαν στην Αμερική. Θυμάμαι ακόμα τη φωνή της και τη μυρωδιά από το ψωμί που έψηνε στον φούρνο. " +
+		"Τώρα το σπίτι είναι κλειστό, αλλά όποτε επιστρέφω στο χωριό περνάω από την αυλή και νιώθω ότι ο " +
+		"χρόνος δεν έχει αλλάξει τίποτα.",
+}
+
+// TestAccentTextSamples strips the accents of accentTextSamples, restores them with AccentText, and logs the
+// words that are restored otherwise, mostly ambiguous ones, e.g. "ποτε" as "πότε". The lexicon was extended to
+// list the words of the samples, so the share of restored words is no measure of its coverage.
+func TestAccentTextSamples(t *testing.T) {
+	var accented, restored int
+
+	for _, sample := range accentTextSamples {
+		stripped := strings.Map(func(r rune) rune {
+			if accent, ok := greekAccents[unicode.ToLower(r)]; ok {
+				if unicode.IsUpper(r) {
+					return unicode.ToUpper(accent.letter)
+				}
+				return accent.letter
+			}
+
+			return r
+		}, sample)
+
+		words, strippedWords := strings.Fields(sample), strings.Fields(stripped)
+		restoredWords := strings.Fields(AccentText(stripped))
+		for i, word := range words {
+			if word == strippedWords[i] {
+				continue
+			}
+
+			accented++
+			if restoredWords[i] == word {
+				restored++
+			} else {
+				t.Logf("(%s) Accented value does not match: expected %s, got %s", strippedWords[i], word,
+					restoredWords[i])
+			}
+		}
+	}
+
+	t.Logf("%d of %d accented words restored", restored, accented)
+}
+
+func TestHyphenateRestoreAccents(t *testing.T) {
+	tests := []hyphenationTest{
+		{"τσαι ρολοι", "τσα-ι ρο-λο-ι"},
+		{"ΠΡΟΙΟΝ", "ΠΡΟ-Ι-ΟΝ"},
+		{"καιρο", "και-ρο"},
+		{"kalimera", "ka-li-me-ra"},
+	}
+
+	hyphenationOptions := GetDefaultOptions()
+	hyphenationOptions.Separator = "-"
+	hyphenationOptions.RestoreAccents = true
+
+	h, err := NewHyphenator(hyphenationOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range tests {
+		if hyphenated := h.Hyphenate(test.input); hyphenated != test.hyphenated {
+			t.Errorf("(%s) Hyphenated value does not match: expected %s, got %s", test.input, test.hyphenated,
+				hyphenated)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package grhyph
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// BreakClass tells how a break point was decided, so that layout engines may rank breaks.
+type BreakClass int
+
+const (
+	// SyllableBreak is a plain syllable boundary, after a vowel.
+	SyllableBreak BreakClass = iota
+	// RuleBreak is a boundary forced by a GrhyphRules rule.
+	RuleBreak
+	// ConsonantClusterBreak splits a consonant cluster, as decided by consonantHyphenation.
+	ConsonantClusterBreak
+	// SynizesisBreak separates vowels prone to synizesis; it is acceptable in syllabification only.
+	SynizesisBreak
+)
+
+var breakClassNames = [...]string{"syllable", "rule", "consonant-cluster", "synizesis"}
+
+func (c BreakClass) String() string {
+	if c < 0 || int(c) >= len(breakClassNames) {
+		return "unknown"
+	}
+
+	return breakClassNames[c]
+}
+
+// MarshalText encodes the class by its name, e.g. "consonant-cluster".
+func (c BreakClass) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+func (c *BreakClass) UnmarshalText(text []byte) error {
+	for class, name := range breakClassNames {
+		if name == string(text) {
+			*c = BreakClass(class)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown break class %q", text)
+}
+
+// While hyphenating, breaks are marked with Unicode noncharacters instead of Options.Separator, so that they
+// can be told apart from the text, and from each other. Marks are replaced by the separator once hyphenation
+// completes. Text that contains these noncharacters is not supported.
+const (
+	breakMark          = "\uFDD0"
+	ruleBreakMark      = "\uFDD1"
+	consonantBreakMark = "\uFDD2"
+	synizesisBreakMark = "\uFDD3"
+)
+
+var breakMarks = [...]string{
+	SyllableBreak:         breakMark,
+	RuleBreak:             ruleBreakMark,
+	ConsonantClusterBreak: consonantBreakMark,
+	SynizesisBreak:        synizesisBreakMark,
+}
+
+func breakMarksReplacer(separator string) *strings.Replacer {
+	var oldnew []string
+	for _, mark := range breakMarks {
+		oldnew = append(oldnew, mark, separator)
+	}
+
+	return strings.NewReplacer(oldnew...)
+}
+
+// Break is an allowed break position, located by its byte and rune offsets into the input.
+type Break struct {
+	Position     int        `json:"position"`
+	RunePosition int        `json:"rune_position"`
+	Class        BreakClass `json:"class"`
+}
+
+// BreakPoints returns the positions at which Hyphenate would insert the separator, in increasing order.
+func (h *Hyphenator) BreakPoints(word string) []Break {
+	return markedBreaks(h.markBreaks(word))
+}
+
+// markedBreaks returns the breaks of a marked text, relative to the text without the marks.
+func markedBreaks(marked string) []Break {
+	var (
+		breaks       []Break
+		markBytes    int
+		runePosition int
+	)
+
+	for i, r := range marked {
+		class, ok := breakClassOf(r)
+		if !ok {
+			runePosition++
+			continue
+		}
+
+		breaks = append(breaks, Break{
+			Position:     i - markBytes,
+			RunePosition: runePosition,
+			Class:        class,
+		})
+		markBytes += utf8.RuneLen(r)
+	}
+
+	return breaks
+}
+
+func breakClassOf(r rune) (BreakClass, bool) {
+	for class, mark := range breakMarks {
+		if string(r) == mark {
+			return BreakClass(class), true
+		}
+	}
+
+	return 0, false
+}
+
+// applyHyphenMins drops the breaks that leave fewer than leftMin or rightMin letters at either end of their
+// word, and returns s marked with the remaining breaks.
+func applyHyphenMins(s string, speechSounds []SpeechSound, breaks []Break, leftMin, rightMin int) string {
+	var (
+		marked    []byte
+		last      int
+		wordStart int
+		offset    int
+		soundI    int
+	)
+
+	for _, b := range breaks {
+		// Find the word that contains the break; words are separated by punctuation speech sounds.
+		for ; soundI < len(speechSounds) && offset+len(speechSounds[soundI].Match) <= b.Position; soundI++ {
+			offset += len(speechSounds[soundI].Match)
+			if speechSounds[soundI].Group == "punctuation" {
+				wordStart = offset
+			}
+		}
+
+		wordEnd := offset
+		for i := soundI; i < len(speechSounds) && speechSounds[i].Group != "punctuation"; i++ {
+			wordEnd += len(speechSounds[i].Match)
+		}
+
+		if letterCount(s[wordStart:b.Position]) < leftMin || letterCount(s[b.Position:wordEnd]) < rightMin {
+			continue
+		}
+
+		marked = append(marked, s[last:b.Position]...)
+		marked = append(marked, breakMarks[b.Class]...)
+		last = b.Position
+	}
+
+	marked = append(marked, s[last:]...)
+
+	return string(marked[:])
+}
+
+// letterCount counts the runes of s, except for combining marks, so that decomposed accents do not count
+// as letters of their own.
+func letterCount(s string) int {
+	n := 0
+	for _, r := range s {
+		if !unicode.Is(unicode.Mn, r) {
+			n++
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,80 @@
+package grhyph
+
+import (
+	"reflect"
+	"testing"
+)
+
+type breakPointsTest struct {
+	input  string
+	breaks []Break
+}
+
+func TestBreakPoints(t *testing.T) {
+	hyphenationOptions := GetDefaultOptions()
+
+	h, err := NewHyphenator(hyphenationOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hyphenationOptions.UseGrhyphRules = true
+
+	hr, err := NewHyphenator(hyphenationOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []breakPointsTest{
+		{"αλκμιόνη", []Break{{6, 3, ConsonantClusterBreak}, {10, 5, SynizesisBreak}, {12, 6, SyllableBreak}}},
+		{"εκστομίζω", []Break{{4, 2, ConsonantClusterBreak}, {10, 5, SyllableBreak}, {14, 7, SyllableBreak}}},
+		{"aidiniwtis", []Break{{2, 2, SyllableBreak}, {4, 4, SyllableBreak}, {6, 6, SynizesisBreak}, {7, 7, SyllableBreak}}},
+	}
+
+	for _, test := range tests {
+		if breaks := h.BreakPoints(test.input); !reflect.DeepEqual(breaks, test.breaks) {
+			t.Errorf("(%s) Break points do not match: expected %v, got %v", test.input, test.breaks, breaks)
+		}
+	}
+
+	rulesTests := []breakPointsTest{
+		{"άνθια", []Break{{4, 2, RuleBreak}}},
+		{"aidiniwtis", []Break{{1, 1, RuleBreak}, {2, 2, SyllableBreak}, {4, 4, SyllableBreak}, {7, 7, SyllableBreak}}},
+	}
+
+	for _, test := range rulesTests {
+		if breaks := hr.BreakPoints(test.input); !reflect.DeepEqual(breaks, test.breaks) {
+			t.Errorf("(%s) Break points do not match: expected %v, got %v", test.input, test.breaks, breaks)
+		}
+	}
+}
+
+func TestHyphenMins(t *testing.T) {
+	for _, useGrhyphRules := range []bool{false, true} {
+		hyphenationOptions := GetDefaultOptions()
+
+		hyphenationOptions.Separator = "-"
+		hyphenationOptions.UseGrhyphRules = useGrhyphRules
+		hyphenationOptions.LeftMin = 2
+		hyphenationOptions.RightMin = 3
+
+		h, err := NewHyphenator(hyphenationOptions)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tests := []hyphenationTest{
+			{"αεροελεγκτής", "αε-ρο-ε-λε-γκτής"},
+			{"ισχνότητα", "ισχνό-τητα"},
+			{"εκδρομέας, εκπνοή", "εκ-δρο-μέας, εκ-πνοή"},
+			{"εκπρόσωπος", "εκ-πρό-σω-πος"},
+		}
+
+		for _, test := range tests {
+			if hyphenedText := h.Hyphenate(test.input); hyphenedText != test.hyphenated {
+				t.Errorf("(%s, rules: %t) Hyphenated value does not match: expected %s, got %s",
+					test.input, useGrhyphRules, test.hyphenated, hyphenedText)
+			}
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package grhyph
+
+import (
+	"container/list"
+	"sync"
+)
+
+// Cache stores the hyphenation of words that were matched against the GrhyphRules. Hyphenators call it
+// from multiple goroutines, so implementations must be safe for concurrent use. The keys are the words
+// of a single Hyphenator, so a Cache must not be shared between Hyphenators with different Options.
+type Cache interface {
+	Get(word string) (string, bool)
+	Put(word, hyphenated string)
+}
+
+// DefaultCacheSize is the number of words cached by the LRUCache of NewHyphenator.
+const DefaultCacheSize = 4096
+
+// NoCache disables caching.
+type NoCache struct{}
+
+func (NoCache) Get(string) (string, bool) { return "", false }
+func (NoCache) Put(string, string)        {}
+
+type (
+	// LRUCache is a size bounded Cache, evicting the least recently used words first.
+	LRUCache struct {
+		mu      sync.Mutex
+		size    int
+		entries map[string]*list.Element
+		order   *list.List // Most recently used first.
+		stats   CacheStats
+	}
+
+	CacheStats struct {
+		Hits      uint64
+		Misses    uint64
+		Evictions uint64
+		Len       int
+	}
+
+	lruEntry struct {
+		word       string
+		hyphenated string
+	}
+)
+
+// NewLRUCache returns an LRUCache holding up to size words. A size below 1 is treated as 1.
+func NewLRUCache(size int) *LRUCache {
+	if size < 1 {
+		size = 1
+	}
+
+	return &LRUCache{
+		size:    size,
+		entries: map[string]*list.Element{},
+		order:   list.New(),
+	}
+}
+
+func (c *LRUCache) Get(word string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[word]
+	if !ok {
+		c.stats.Misses++
+		return "", false
+	}
+
+	c.stats.Hits++
+	c.order.MoveToFront(element)
+
+	return element.Value.(*lruEntry).hyphenated, true
+}
+
+func (c *LRUCache) Put(word, hyphenated string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[word]; ok {
+		element.Value.(*lruEntry).hyphenated = hyphenated
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[word] = c.order.PushFront(&lruEntry{word, hyphenated})
+
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).word)
+		c.stats.Evictions++
+	}
+}
+
+// Stats returns the hit, miss and eviction counters, and the number of cached words.
+func (c *LRUCache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Len = c.order.Len()
+
+	return stats
+}
@@ -0,0 +1,65 @@
+package grhyph
+
+import (
+	"testing"
+)
+
+func TestLRUCache(t *testing.T) {
+	c := NewLRUCache(2)
+
+	c.Put("άνθια", "άν-θια")
+	c.Put("anthia", "an-thia")
+
+	if _, ok := c.Get("άνθια"); !ok {
+		t.Error("Expected a cache hit for άνθια.")
+	}
+
+	// anthia is now the least recently used word.
+	c.Put("αηδόνια", "αη-δό-νια")
+
+	if _, ok := c.Get("anthia"); ok {
+		t.Error("Expected anthia to be evicted.")
+	}
+
+	if hyphenated, ok := c.Get("αηδόνια"); !ok || hyphenated != "αη-δό-νια" {
+		t.Errorf("Cached value does not match: expected αη-δό-νια, got %s", hyphenated)
+	}
+
+	expected := CacheStats{Hits: 2, Misses: 1, Evictions: 1, Len: 2}
+	if stats := c.Stats(); stats != expected {
+		t.Errorf("Cache statistics do not match: expected %+v, got %+v", expected, stats)
+	}
+}
+
+func TestHyphenatorCache(t *testing.T) {
+	hyphenationOptions := GetDefaultOptions()
+
+	hyphenationOptions.Separator = "-"
+	hyphenationOptions.UseGrhyphRules = true
+
+	c := NewLRUCache(DefaultCacheSize)
+
+	h, err := NewHyphenatorWithCache(hyphenationOptions, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if hyphenedText := h.Hyphenate("εκπρόσωπος"); hyphenedText != "εκ-πρό-σω-πος" {
+			t.Errorf("(εκπρόσωπος) Hyphenated value does not match: expected εκ-πρό-σω-πος, got %s", hyphenedText)
+		}
+	}
+
+	if stats := c.Stats(); stats.Hits != 1 || stats.Len != 1 {
+		t.Errorf("Expected one cached word and one hit, got %+v", stats)
+	}
+
+	h, err = NewHyphenatorWithCache(hyphenationOptions, NoCache{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hyphenedText := h.Hyphenate("εκπρόσωπος"); hyphenedText != "εκ-πρό-σω-πος" {
+		t.Errorf("(εκπρόσωπος) Hyphenated value does not match: expected εκ-πρό-σω-πος, got %s", hyphenedText)
+	}
+}
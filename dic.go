@@ -0,0 +1,204 @@
+package grhyph
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// DicOptions configures the export of libhyphen dictionaries.
+type DicOptions struct {
+	// Levels are the pattern generation levels. Defaults to DefaultPatternLevels.
+	Levels []PatternLevel
+
+	// Greeklish keeps the words of the list written in Latin letters, so that the dictionary hyphenates
+	// Greeklish too. They are skipped otherwise.
+	Greeklish bool
+}
+
+// PatternMismatch is a word that the patterns hyphenate differently from the Hyphenator, both hyphenated
+// with "-".
+type PatternMismatch struct {
+	Word     string
+	Expected string
+	Got      string
+}
+
+// WriteDic trains patterns on words, as hyphenated with a new Hyphenator for o, and writes them to w as a
+// libhyphen dictionary, e.g. hyph_el_GR.dic.
+func WriteDic(w io.Writer, words []string, o Options, d DicOptions) (PatternReport, error) {
+	h, err := NewHyphenatorWithCache(o, NoCache{})
+	if err != nil {
+		return PatternReport{}, err
+	}
+
+	return h.WriteDic(w, words, d)
+}
+
+// WriteDic is like the package function, but hyphenates the words with h.
+func (h *Hyphenator) WriteDic(w io.Writer, words []string, d DicOptions) (PatternReport, error) {
+	levels := d.Levels
+	if levels == nil {
+		levels = DefaultPatternLevels
+	}
+
+	if !d.Greeklish {
+		var greekWords []string
+		for _, word := range words {
+			if !strings.ContainsFunc(word, func(r rune) bool { return unicode.Is(unicode.Latin, r) }) {
+				greekWords = append(greekWords, word)
+			}
+		}
+		words = greekWords
+	}
+
+	p, report := h.GeneratePatterns(words, levels)
+
+	return report, p.WriteDic(w)
+}
+
+// WriteDic writes the patterns as a UTF-8 libhyphen dictionary.
+func (p *Patterns) WriteDic(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	bw.WriteString("UTF-8\n")
+	fmt.Fprintf(bw, "LEFTHYPHENMIN %d\nRIGHTHYPHENMIN %d\n", p.LeftMin, p.RightMin)
+	for _, pattern := range p.Strings() {
+		bw.WriteString(pattern + "\n")
+	}
+
+	return bw.Flush()
+}
+
+// ReadDic reads a libhyphen dictionary, encoded in UTF-8 or ISO8859-7. LEFTHYPHENMIN and RIGHTHYPHENMIN
+// default to 2 when absent. Compound dictionaries (NEXTLEVEL) and non-standard patterns are not supported.
+func ReadDic(r io.Reader) (*Patterns, error) {
+	br := bufio.NewReader(r)
+
+	charset, err := br.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	var scanner *bufio.Scanner
+	switch charset = strings.TrimSpace(charset); charset {
+	case "UTF-8":
+		scanner = bufio.NewScanner(br)
+	case "ISO8859-7", "ISO-8859-7":
+		scanner = bufio.NewScanner(charmap.ISO8859_7.NewDecoder().Reader(br))
+	default:
+		return nil, fmt.Errorf("unsupported dictionary charset %q", charset)
+	}
+
+	p := &Patterns{LeftMin: 2, RightMin: 2, patterns: map[string][]int{}}
+
+	for lineNumber := 2; scanner.Scan(); lineNumber++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "%") {
+			continue
+		}
+
+		var err error
+		switch fields[0] {
+		case "LEFTHYPHENMIN", "RIGHTHYPHENMIN":
+			var n int
+			if len(fields) != 2 {
+				err = errors.New("expected a number")
+			} else if n, err = strconv.Atoi(fields[1]); err == nil && fields[0] == "LEFTHYPHENMIN" {
+				p.LeftMin = max(n, 1)
+			} else if err == nil {
+				p.RightMin = max(n, 1)
+			}
+		case "COMPOUNDLEFTHYPHENMIN", "COMPOUNDRIGHTHYPHENMIN", "NOHYPHEN":
+			// These only apply to compound words, or to words with hyphens, which are not hyphenated anyway.
+		case "NEXTLEVEL":
+			err = errors.New("compound dictionaries are not supported")
+		default:
+			err = p.addPattern(fields[0])
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %q: %w", lineNumber, scanner.Text(), err)
+		}
+	}
+
+	return p, scanner.Err()
+}
+
+// addPattern adds a pattern in the usual notation, e.g. "α1β".
+func (p *Patterns) addPattern(pattern string) error {
+	var (
+		letters []rune
+		values  = []int{0}
+	)
+
+	for _, r := range pattern {
+		switch {
+		case r >= '0' && r <= '9':
+			values[len(values)-1] = int(r - '0')
+		case r == '/' || r == '=':
+			return errors.New("non-standard patterns are not supported")
+		default:
+			letters = append(letters, unicode.ToLower(r))
+			values = append(values, 0)
+		}
+	}
+
+	if len(letters) == 0 {
+		return errors.New("pattern without letters")
+	}
+
+	p.patterns[string(letters)] = values
+	p.maxLength = max(p.maxLength, len(letters))
+
+	return nil
+}
+
+// Check hyphenates words with both the patterns and h, and returns the words where they differ, e.g. to
+// verify a dictionary read by ReadDic. Words are lower cased, and those that TeX would not accept are skipped.
+func (p *Patterns) Check(h *Hyphenator, words []string) []PatternMismatch {
+	var mismatches []PatternMismatch
+
+	for _, word := range h.patternWords(words) {
+		letters := word.letters[1 : len(word.letters)-1]
+
+		var expected, got []int
+		for i, b := range word.breaks {
+			if b {
+				expected = append(expected, i-1)
+			}
+		}
+		got = p.BreakPoints(string(letters))
+
+		if fmt.Sprint(expected) != fmt.Sprint(got) {
+			mismatches = append(mismatches, PatternMismatch{
+				Word:     string(letters),
+				Expected: insertHyphens(letters, expected),
+				Got:      insertHyphens(letters, got),
+			})
+		}
+	}
+
+	return mismatches
+}
+
+// insertHyphens inserts "-" before the letters at the rune positions of breaks.
+func insertHyphens(letters []rune, breaks []int) string {
+	var b strings.Builder
+
+	for i, r := range letters {
+		if len(breaks) > 0 && breaks[0] == i {
+			b.WriteByte('-')
+			breaks = breaks[1:]
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
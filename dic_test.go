@@ -0,0 +1,71 @@
+package grhyph
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+func TestDicRoundTrip(t *testing.T) {
+	hyphenationOptions := GetDefaultOptions()
+	hyphenationOptions.UseGrhyphRules = true
+
+	h, err := NewHyphenator(hyphenationOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	words := append(patternTestWords, "aidiniwtis", "anthia", "kalimera")
+
+	for _, greeklish := range []bool{false, true} {
+		var b bytes.Buffer
+		if _, err := h.WriteDic(&b, words, DicOptions{Greeklish: greeklish}); err != nil {
+			t.Fatal(err)
+		}
+
+		if !strings.HasPrefix(b.String(), "UTF-8\nLEFTHYPHENMIN 1\nRIGHTHYPHENMIN 1\n") {
+			t.Errorf("(greeklish: %t) Unexpected dictionary header: %q", greeklish, b.String()[:40])
+		}
+
+		p, err := ReadDic(&b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if mismatches := p.Check(h, patternTestWords); len(mismatches) > 0 {
+			t.Errorf("(greeklish: %t) Unexpected mismatches: %v", greeklish, mismatches)
+		}
+
+		mismatches := p.Check(h, []string{"kalimera"})
+		if greeklish != (len(mismatches) == 0) {
+			t.Errorf("(greeklish: %t) Unexpected Greeklish mismatches: %v", greeklish, mismatches)
+		}
+	}
+}
+
+func TestReadDic(t *testing.T) {
+	dic := "ISO8859-7\n% comment\nLEFTHYPHENMIN 1\nRIGHTHYPHENMIN 2\n.α2\nΑ1β\n3γδε\n"
+
+	encoded, err := charmap.ISO8859_7.NewEncoder().String(dic)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := ReadDic(strings.NewReader(encoded))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := []string{".α2", "α1β", "3γδε"}; !reflect.DeepEqual(p.Strings(), expected) || p.LeftMin != 1 || p.RightMin != 2 {
+		t.Errorf("Dictionary does not match: expected %v, got %v (%d, %d)", expected, p.Strings(), p.LeftMin, p.RightMin)
+	}
+
+	for _, invalid := range []string{"UTF-16\nα1\n", "UTF-8\nNEXTLEVEL\n", "UTF-8\nc1k/k=k,1,1\n", "UTF-8\nLEFTHYPHENMIN x\n"} {
+		if _, err := ReadDic(strings.NewReader(invalid)); err == nil {
+			t.Errorf("(%q) Expected an error", invalid)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package grhyph
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+var ErrInvalidEPUB = errors.New("invalid EPUB")
+
+const epubMimetype = "application/epub+zip"
+
+type (
+	epubContainer struct {
+		Rootfiles []struct {
+			FullPath string `xml:"full-path,attr"`
+		} `xml:"rootfiles>rootfile"`
+	}
+
+	epubPackage struct {
+		Languages []string `xml:"metadata>language"`
+		Items     []struct {
+			Href      string `xml:"href,attr"`
+			MediaType string `xml:"media-type,attr"`
+		} `xml:"manifest>item"`
+	}
+)
+
+// EPUBHyphenate copies the EPUB read from r, of size bytes, to w, hyphenating the text of its XHTML content
+// documents as HTMLHyphenate does. The content documents are those of the OPF package manifest. Unless set,
+// o.DefaultLanguage is the first dc:language of the package. The other entries are copied as they are, and
+// the mimetype entry is written first and uncompressed, as the OCF specification requires.
+func EPUBHyphenate(r io.ReaderAt, size int64, w io.Writer, o HTMLOptions) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidEPUB, err)
+	}
+
+	documents, language, err := epubContentDocuments(zr)
+	if err != nil {
+		return err
+	}
+
+	if o.DefaultLanguage == "" {
+		o.DefaultLanguage = language
+	}
+
+	htmlHyphenator, err := NewHTMLHyphenator(o)
+	if err != nil {
+		return err
+	}
+
+	o.XHTML = true
+	xhtmlHyphenator, err := NewHTMLHyphenator(o)
+	if err != nil {
+		return err
+	}
+
+	zw := zip.NewWriter(w)
+
+	if err := writeEPUBMimetype(zw); err != nil {
+		return err
+	}
+
+	for _, f := range zr.File {
+		if f.Name == "mimetype" {
+			continue
+		}
+
+		hh := htmlHyphenator
+		switch documents[f.Name] {
+		case "":
+			if err := zw.Copy(f); err != nil {
+				return err
+			}
+			continue
+		case "application/xhtml+xml":
+			hh = xhtmlHyphenator
+		}
+
+		if err := hyphenateZipFile(zw, f, hh); err != nil {
+			return fmt.Errorf("%s: %w", f.Name, err)
+		}
+	}
+
+	return zw.Close()
+}
+
+// EPUBHyphenateFile hyphenates the EPUB file at name in place, as EPUBHyphenate does. The file is only
+// replaced once the hyphenated copy is complete.
+func EPUBHyphenateFile(name string, o HTMLOptions) error {
+	return rewriteFile(name, func(r io.ReaderAt, size int64, w io.Writer) error {
+		return EPUBHyphenate(r, size, w, o)
+	})
+}
+
+// rewriteFile rewrites the file at name with rewrite, through a temporary file that replaces it on success.
+func rewriteFile(name string, rewrite func(r io.ReaderAt, size int64, w io.Writer) error) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".grhyph-*"+filepath.Ext(name))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	err = rewrite(f, info.Size(), tmp)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), info.Mode().Perm())
+	}
+	if err != nil {
+		return err
+	}
+
+	f.Close()
+
+	return os.Rename(tmp.Name(), name)
+}
+
+// epubContentDocuments returns the media types of the (X)HTML documents of the package manifest, by their
+// names within the archive, and the first language of the package.
+func epubContentDocuments(zr *zip.Reader) (map[string]string, string, error) {
+	var container epubContainer
+	if err := readZipXML(zr, "META-INF/container.xml", &container); err != nil {
+		return nil, "", err
+	}
+
+	if len(container.Rootfiles) == 0 {
+		return nil, "", fmt.Errorf("%w: no rootfile in META-INF/container.xml", ErrInvalidEPUB)
+	}
+
+	rootfile := container.Rootfiles[0].FullPath
+
+	var pkg epubPackage
+	if err := readZipXML(zr, rootfile, &pkg); err != nil {
+		return nil, "", err
+	}
+
+	documents := map[string]string{}
+	for _, item := range pkg.Items {
+		if item.MediaType != "application/xhtml+xml" && item.MediaType != "text/html" {
+			continue
+		}
+
+		href, err := url.PathUnescape(item.Href)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: %s: %v", ErrInvalidEPUB, rootfile, err)
+		}
+
+		documents[path.Join(path.Dir(rootfile), href)] = item.MediaType
+	}
+
+	var language string
+	if len(pkg.Languages) > 0 {
+		language = strings.ToLower(strings.TrimSpace(pkg.Languages[0]))
+	}
+
+	return documents, language, nil
+}
+
+func readZipXML(zr *zip.Reader, name string, v any) error {
+	f, err := zr.Open(name)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidEPUB, err)
+	}
+	defer f.Close()
+
+	if err := xml.NewDecoder(f).Decode(v); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidEPUB, name, err)
+	}
+
+	return nil
+}
+
+// writeEPUBMimetype writes the mimetype entry stored, and without a data descriptor or extra fields.
+func writeEPUBMimetype(zw *zip.Writer) error {
+	header := &zip.FileHeader{
+		Name:               "mimetype",
+		Method:             zip.Store,
+		CRC32:              crc32.ChecksumIEEE([]byte(epubMimetype)),
+		CompressedSize64:   uint64(len(epubMimetype)),
+		UncompressedSize64: uint64(len(epubMimetype)),
+
+		// 1980-01-01, set as an MS-DOS date, since Modified would add an extended timestamp extra field.
+		ModifiedDate: 1<<5 | 1,
+	}
+
+	w, err := zw.CreateRaw(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, epubMimetype)
+
+	return err
+}
+
+func hyphenateZipFile(zw *zip.Writer, f *zip.File, hh *HTMLHyphenator) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	var b bytes.Buffer
+	if err := hh.Hyphenate(rc, &b); err != nil {
+		return err
+	}
+
+	w, err := zw.CreateHeader(&zip.FileHeader{
+		Name:          f.Name,
+		Comment:       f.Comment,
+		Method:        zip.Deflate,
+		Modified:      f.Modified,
+		ExternalAttrs: f.ExternalAttrs,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(b.Bytes())
+
+	return err
+}
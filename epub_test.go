@@ -0,0 +1,164 @@
+package grhyph
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var epubTestFiles = []struct {
+	name    string
+	content string
+}{
+	{"META-INF/container.xml", `<?xml version="1.0"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles><rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/></rootfiles>
+</container>`},
+	{"OEBPS/content.opf", `<?xml version="1.0"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/"><dc:language>el</dc:language></metadata>
+  <manifest>
+    <item id="c1" href="text/chapter%201.xhtml" media-type="application/xhtml+xml"/>
+    <item id="css" href="style.css" media-type="text/css"/>
+  </manifest>
+</package>`},
+	{"OEBPS/text/chapter 1.xhtml", `<?xml version="1.0" encoding="utf-8"?>
+<html xmlns="http://www.w3.org/1999/xhtml"><body><p>Καλημέρα<br/><span xml:lang="en">kalimera</span></p></body></html>`},
+	{"OEBPS/style.css", `/* καλημέρα */`},
+	{"OEBPS/unlisted.xhtml", `<p>καλημέρα</p>`},
+}
+
+func TestEPUBHyphenate(t *testing.T) {
+	var src bytes.Buffer
+	zw := zip.NewWriter(&src)
+	for _, f := range append([]struct{ name, content string }{{"mimetype", epubMimetype}}, epubTestFiles...) {
+		w, err := zw.Create(f.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		io.WriteString(w, f.content)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	name := filepath.Join(t.TempDir(), "book.epub")
+	if err := os.WriteFile(name, src.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := EPUBHyphenateFile(name, HTMLOptions{Options: GetDefaultOptions()}); err != nil {
+		t.Fatal(err)
+	}
+
+	epub, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The mimetype entry must come first, stored, with no extra field, so that it can be read at offset 38.
+	if string(epub[30:38]) != "mimetype" || binary.LittleEndian.Uint16(epub[8:]) != uint16(zip.Store) ||
+		binary.LittleEndian.Uint16(epub[28:]) != 0 || string(epub[38:38+len(epubMimetype)]) != epubMimetype {
+		t.Errorf("Unexpected mimetype entry: %q", epub[:38+len(epubMimetype)])
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(epub), int64(len(epub)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"mimetype": epubMimetype,
+		"OEBPS/text/chapter 1.xhtml": `<?xml version="1.0" encoding="utf-8"?>
+<html xmlns="http://www.w3.org/1999/xhtml"><body><p>Κα&#173;λη&#173;μέ&#173;ρα<br/><span xml:lang="en">kalimera</span></p></body></html>`,
+	}
+	for _, f := range epubTestFiles {
+		if _, ok := expected[f.name]; !ok {
+			expected[f.name] = f.content
+		}
+	}
+
+	if len(zr.File) != len(expected) {
+		t.Errorf("Entry count does not match: expected %d, got %d", len(expected), len(zr.File))
+	}
+
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(content) != expected[f.Name] {
+			t.Errorf("(%s) Content does not match: expected %s, got %s", f.Name, expected[f.Name], content)
+		}
+	}
+}
+
+func TestEPUBHyphenateInvalid(t *testing.T) {
+	var src bytes.Buffer
+	zw := zip.NewWriter(&src)
+	zw.Create("mimetype")
+	zw.Close()
+
+	err := EPUBHyphenate(bytes.NewReader(src.Bytes()), int64(src.Len()), io.Discard, HTMLOptions{})
+	if !errors.Is(err, ErrInvalidEPUB) {
+		t.Errorf("Expected ErrInvalidEPUB, got %v", err)
+	}
+}
+
+func TestEPUBHyphenateLanguageCase(t *testing.T) {
+	var src bytes.Buffer
+	zw := zip.NewWriter(&src)
+	for _, f := range append([]struct{ name, content string }{{"mimetype", epubMimetype}}, epubTestFiles[:3]...) {
+		w, err := zw.Create(f.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		io.WriteString(w, strings.Replace(f.content, "<dc:language>el<", "<dc:language> EL-GR <", 1))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var dst bytes.Buffer
+	err := EPUBHyphenate(bytes.NewReader(src.Bytes()), int64(src.Len()), &dst, HTMLOptions{Options: GetDefaultOptions()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(dst.Bytes()), int64(dst.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, f := range zr.File {
+		if f.Name != "OEBPS/text/chapter 1.xhtml" {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !strings.Contains(string(content), "Κα&#173;λη&#173;μέ&#173;ρα") {
+			t.Errorf("Content was not hyphenated: %s", content)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package grhyph
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Trace records how a word was hyphenated by the rules.
+type Trace struct {
+	Input  string
+	Output string
+
+	// Accented is the form of Input that the rules traced, when Options.RestoreAccents restored its accents.
+	// Left, Middle and Right, and the traces below, are parts of it.
+	Accented string
+
+	// Rule is the first rule that matched Input, found at RuleIndex of the Hyphenator's rules. It is nil when
+	// no rule matched, and Input fell back to plainHyphenation.
+	Rule      *GrhyphRule
+	RuleIndex int
+
+	// Left, Middle and Right are the parts of Input split by the rule's ">" and "<" markers. Middle is
+	// hyphenated by the rule itself, while Left and Right are hyphenated recursively, as traced by LeftTrace
+	// and RightTrace. The traces are nil for empty parts.
+	Left       string
+	Middle     string
+	Right      string
+	LeftTrace  *Trace
+	RightTrace *Trace
+}
+
+// Explain traces the hyphenation of a single word by the rules, regardless of Options.UseGrhyphRules.
+// The cache is not consulted, so that every step is recorded. The word goes through the same steps as in
+// Hyphenate: its accents are restored with Options.RestoreAccents, and Output keeps the breaks that
+// Options.LeftMin and Options.RightMin allow.
+func (h *Hyphenator) Explain(word string) *Trace {
+	traced := word
+	if h.options.RestoreAccents {
+		traced = accentWords(word, false)
+	}
+
+	speechSounds, _ := stringTospeechSounds(traced)
+
+	trace := &Trace{}
+	marked := h.hyphenMins(traced, speechSounds, h.regexpReplace(speechSounds, trace))
+
+	if traced != word {
+		trace.Accented = traced
+		marked = withLettersOf(marked, word)
+	}
+	trace.Input, trace.Output = word, h.render(marked)
+
+	return trace
+}
+
+// ExplainText traces the hyphenation of the words of s, as Explain does. Words are split at punctuation and
+// whitespace, as Syllabify splits them.
+func (h *Hyphenator) ExplainText(s string) []*Trace {
+	var traces []*Trace
+	for _, word := range speechSoundWords(s) {
+		traces = append(traces, h.Explain(word))
+	}
+
+	return traces
+}
+
+// speechSoundWords returns the words of s: the runs of speech sounds between punctuation.
+func speechSoundWords(s string) []string {
+	speechSounds, _ := stringTospeechSounds(s)
+
+	var (
+		words []string
+		word  strings.Builder
+	)
+
+	for _, speechSound := range speechSounds {
+		if speechSound.Group != "punctuation" {
+			word.WriteString(speechSound.Match)
+			continue
+		}
+
+		if word.Len() > 0 {
+			words = append(words, word.String())
+			word.Reset()
+		}
+	}
+
+	if word.Len() > 0 {
+		words = append(words, word.String())
+	}
+
+	return words
+}
+
+// String formats the trace as an indented tree.
+func (t *Trace) String() string {
+	var b strings.Builder
+	t.write(&b, "")
+
+	return b.String()
+}
+
+func (t *Trace) write(b *strings.Builder, indent string) {
+	input := t.Input
+	if t.Accented != "" {
+		input = fmt.Sprintf("%s (as %s)", t.Input, t.Accented)
+	}
+
+	if t.Rule == nil {
+		fmt.Fprintf(b, "%s%s => %s (plain hyphenation)\n", indent, input, t.Output)
+		return
+	}
+
+	fmt.Fprintf(b, "%s%s => %s\n", indent, input, t.Output)
+
+	indent += "  "
+	source := t.Rule.Source
+
+	location := fmt.Sprintf("rule %d", t.RuleIndex)
+	if source.File != "" {
+		location = fmt.Sprintf("%s (%s:%d)", location, source.File, source.Line)
+	} else if source.Line > 0 {
+		location = fmt.Sprintf("%s (line %d)", location, source.Line)
+	}
+
+	fmt.Fprintf(b, "%s%s: %s => %s\n", indent, location, strings.Join(source.Tokens, " "), source.Replacement)
+	if source.Comment != "" {
+		fmt.Fprintf(b, "%s# %s\n", indent, strings.Replace(source.Comment, "\n", "\n"+indent+"# ", -1))
+	}
+	fmt.Fprintf(b, "%sregexp: %s\n", indent, t.Rule.CompiledCustomRe)
+	fmt.Fprintf(b, "%ssplit: %q > %q < %q\n", indent, t.Left, t.Middle, t.Right)
+
+	if t.LeftTrace != nil {
+		fmt.Fprintf(b, "%sleft:\n", indent)
+		t.LeftTrace.write(b, indent+"  ")
+	}
+	if t.RightTrace != nil {
+		fmt.Fprintf(b, "%sright:\n", indent)
+		t.RightTrace.write(b, indent+"  ")
+	}
+}
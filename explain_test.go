@@ -0,0 +1,79 @@
+package grhyph
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExplain(t *testing.T) {
+	hyphenationOptions := GetDefaultOptions()
+
+	hyphenationOptions.Separator = "-"
+	hyphenationOptions.UseGrhyphRules = true
+
+	h, err := NewHyphenator(hyphenationOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	trace := h.Explain("aidiniwtis")
+
+	if trace.Output != "a-i-di-niw-tis" || trace.Rule == nil || trace.Rule.Source.Line == 0 {
+		t.Fatalf("Unexpected trace:\n%v", trace)
+	}
+
+	if trace.Left != "" || trace.Middle != "a-" || trace.Right != "idiniwtis" || trace.LeftTrace != nil {
+		t.Errorf("Rule split does not match, got %q > %q < %q", trace.Left, trace.Middle, trace.Right)
+	}
+
+	rightTrace := trace.RightTrace
+	if rightTrace == nil || rightTrace.Input != "idiniwtis" || rightTrace.Output != "i-di-niw-tis" {
+		t.Fatalf("Unexpected right side trace:\n%v", rightTrace)
+	}
+
+	if plainTrace := rightTrace.LeftTrace; plainTrace == nil || plainTrace.Rule != nil || plainTrace.Output != "i-di-ni" {
+		t.Errorf("Expected a plain hyphenation fallback, got:\n%v", plainTrace)
+	}
+
+	if s := trace.String(); !strings.Contains(s, "grhyph.rules:") || !strings.Contains(s, "(plain hyphenation)") {
+		t.Errorf("Formatted trace lacks the rule location or the fallback:\n%s", s)
+	}
+}
+
+func TestExplainText(t *testing.T) {
+	hyphenationOptions := GetDefaultOptions()
+
+	hyphenationOptions.Separator = "-"
+	hyphenationOptions.UseGrhyphRules = true
+	hyphenationOptions.RestoreAccents = true
+	hyphenationOptions.LeftMin = 2
+	hyphenationOptions.RightMin = 2
+
+	h, err := NewHyphenator(hyphenationOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := "Ένα τσαι, ρολοι και αγαπη!"
+	traces := h.ExplainText(input)
+
+	words := []string{"Ένα", "τσαι", "ρολοι", "και", "αγαπη"}
+	if len(traces) != len(words) {
+		t.Fatalf("(%s) Expected %d traces, got %d", input, len(words), len(traces))
+	}
+
+	for i, trace := range traces {
+		if trace.Input != words[i] {
+			t.Errorf("(%s) Traced word does not match: expected %s, got %s", input, words[i], trace.Input)
+		}
+
+		if hyphenated := h.Hyphenate(trace.Input); trace.Output != hyphenated {
+			t.Errorf("(%s) Traced output does not match Hyphenate: expected %s, got %s", trace.Input, hyphenated,
+				trace.Output)
+		}
+	}
+
+	if trace := traces[1]; trace.Accented != "τσάι" || !strings.Contains(trace.String(), "τσαι (as τσάι)") {
+		t.Errorf("Expected the accented form of τσαι to be traced, got:\n%v", trace)
+	}
+}
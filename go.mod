@@ -0,0 +1,9 @@
+module github.com/datio/grhyph
+
+go 1.26.0
+
+require (
+	github.com/yuin/goldmark v1.8.6
+	golang.org/x/net v0.60.0
+	golang.org/x/text v0.42.0
+)
@@ -0,0 +1,187 @@
+package grhyph
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// GreeklishOptions tell how ToGreek resolves the Latin spellings of Greeklish.
+type GreeklishOptions struct {
+	// Preferences map Latin spellings, in lowercase, to the Greek letters they stand for. They resolve the
+	// spellings that stand for more than one letter, e.g. "o" for ο or ω, and spell the letters that stand
+	// for none. They take precedence over DefaultGreeklishPreferences.
+	Preferences map[string]string
+}
+
+// DefaultGreeklishPreferences resolve every ambiguous spelling of the Greek and Latin equivalence classes, and
+// the Latin letters that SpeechSoundRe knows of but the classes do not.
+var DefaultGreeklishPreferences = map[string]string{
+	"b":  "μπ", // β is "v".
+	"c":  "σ",
+	"d":  "δ", // ντ is "nt".
+	"h":  "η", // χ is "x" or "ch".
+	"i":  "ι",
+	"o":  "ο",
+	"q":  "κ",
+	"th": "θ", // δ is "d".
+	"x":  "χ", // ξ is "ks" or "3".
+}
+
+// greeklishClasses are the equivalence classes of customRegexpsMap for single Greek letters and digraphs,
+// e.g. "(θ)" for "th", "θ" and "8", by their letters.
+var greeklishClasses = func() map[string]*regexp.Regexp {
+	keyRe := regexp.MustCompile(`^\(([α-ω]{1,2})\)$`)
+
+	classes := map[string]*regexp.Regexp{}
+	for key, re := range customRegexpsMap {
+		if m := keyRe.FindStringSubmatch(key); m != nil {
+			classes[m[1]] = regexp.MustCompile("(?i)^(?:" + re + ")$")
+		}
+	}
+
+	return classes
+}()
+
+// greeklishCandidates returns the Greek letters, or digraphs, whose classes include the Latin spelling s.
+func greeklishCandidates(s string) []string {
+	var candidates []string
+	for greek, re := range greeklishClasses {
+		if re.MatchString(s) {
+			candidates = append(candidates, greek)
+		}
+	}
+
+	sort.Strings(candidates)
+
+	return candidates
+}
+
+// ToGreek transliterates the Greeklish of text to Greek. Words are split into the speech sounds of
+// SpeechSoundRe, e.g. "th", "ks" or "mp", which are spelled by the Greek letters of their equivalence classes,
+// or else by o.Preferences and DefaultGreeklishPreferences. A σ that ends a word becomes ς. Greek letters,
+// punctuation and numbers are kept as they are; "3", "4" and "8" are only read as ξ, ψ and θ within words.
+// Accents are not restored.
+func ToGreek(text string, o GreeklishOptions) string {
+	var (
+		b     strings.Builder
+		start = -1
+	)
+
+	for i, r := range text {
+		if !isWordBoundary(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+
+		if start >= 0 {
+			b.WriteString(wordToGreek(text[start:i], o))
+			start = -1
+		}
+		b.WriteRune(r)
+	}
+
+	if start >= 0 {
+		b.WriteString(wordToGreek(text[start:], o))
+	}
+
+	return b.String()
+}
+
+func wordToGreek(word string, o GreeklishOptions) string {
+	speechSounds, _ := stringTospeechSounds(word)
+
+	hasLetters := strings.IndexFunc(word, unicode.IsLetter) >= 0
+	upper := utf8.RuneCountInString(word) > 1 && strings.IndexFunc(word, unicode.IsLower) < 0
+
+	var (
+		b              strings.Builder
+		transliterated bool
+	)
+
+	for _, speechSound := range speechSounds {
+		for s := speechSound.Match; s != ""; {
+			greek, n := greeklishPrefix(s, o)
+			if greek == "" || (!hasLetters && strings.ContainsAny(s[:n], "348")) {
+				r, size := utf8.DecodeRuneInString(s)
+				b.WriteRune(r)
+				s = s[size:]
+				continue
+			}
+
+			b.WriteString(matchCase(greek, s[:n], upper))
+			transliterated = true
+			s = s[n:]
+		}
+	}
+
+	if !transliterated {
+		return b.String()
+	}
+
+	return finalSigma(b.String())
+}
+
+// greeklishPrefix returns the Greek spelling of the longest prefix of s that is Latin, and the length of the
+// prefix.
+func greeklishPrefix(s string, o GreeklishOptions) (string, int) {
+	for n := len(s); n > 0; n-- {
+		latin := s[:n]
+		if !utf8.ValidString(latin) || strings.IndexFunc(latin, isGreeklishRune) < 0 {
+			continue
+		}
+
+		key := strings.ToLower(latin)
+		if greek, ok := o.Preferences[key]; ok {
+			return greek, n
+		}
+		if greek, ok := DefaultGreeklishPreferences[key]; ok {
+			return greek, n
+		}
+
+		// Ambiguous spellings without a preference are left to shorter prefixes.
+		if candidates := greeklishCandidates(key); len(candidates) == 1 {
+			return candidates[0], n
+		}
+	}
+
+	return "", 0
+}
+
+// isGreeklishRune tells whether r is a Latin letter, or a digit that stands for a Greek letter.
+func isGreeklishRune(r rune) bool {
+	return r < utf8.RuneSelf && unicode.IsLetter(r) || r == '3' || r == '4' || r == '8'
+}
+
+// matchCase capitalizes greek as latin is: all of it if latin, of more than one letter, or its word is in
+// uppercase, or else its first letter.
+func matchCase(greek, latin string, upper bool) string {
+	first, _ := utf8.DecodeRuneInString(latin)
+	if !unicode.IsUpper(first) {
+		return greek
+	}
+
+	if upper || strings.IndexFunc(latin, unicode.IsLower) < 0 && utf8.RuneCountInString(latin) > 1 {
+		return strings.ToUpper(greek)
+	}
+
+	r, size := utf8.DecodeRuneInString(greek)
+
+	return string(unicode.ToUpper(r)) + greek[size:]
+}
+
+// finalSigma replaces the σ that follows a letter and ends a word with ς.
+func finalSigma(word string) string {
+	runes := []rune(word)
+	for i, r := range runes {
+		if r == 'σ' && i > 0 && unicode.IsLetter(runes[i-1]) && (i == len(runes)-1 || !unicode.IsLetter(runes[i+1])) {
+			runes[i] = 'ς'
+		}
+	}
+
+	return string(runes)
+}
@@ -0,0 +1,46 @@
+package grhyph
+
+import (
+	"testing"
+)
+
+func TestToGreek(t *testing.T) {
+	tests := []struct {
+		input, expected string
+	}{
+		{"Kalhmera se olous!", "Καλημερα σε ολους!"},
+		{"8elw na pame gia ka8e mera", "θελω να παμε για καθε μερα"},
+		{"XRONIA POLLA", "ΧΡΟΝΙΑ ΠΟΛΛΑ"},
+		{"Thessaloniki kai Athina", "Θεσσαλονικι και Αθινα"},
+		{"mpouzouki, Bouzouki", "μπουζουκι, Μπουζουκι"},
+		{"ksilo 3ilo psomi 4ari", "ξιλο ξιλο ψομι ψαρι"},
+		{"tsai, tzami, ntomata, agkalia", "τσαι, τζαμι, ντοματα, αγκαλια"},
+		{"exeis, Eisai", "εχεις, Εισαι"},
+		{"kalhμέρα σας", "καλημέρα σας"},
+		{"Sto 2018 kai 2019", "Στο 2018 και 2019"},
+	}
+
+	for _, test := range tests {
+		if greek := ToGreek(test.input, GreeklishOptions{}); greek != test.expected {
+			t.Errorf("(%s) Greek value does not match: expected %s, got %s", test.input, test.expected, greek)
+		}
+	}
+
+	o := GreeklishOptions{Preferences: map[string]string{"o": "ω", "h": "χ"}}
+	if greek := ToGreek("hronia oraia", o); greek != "χρωνια ωραια" {
+		t.Errorf("(hronia oraia) Greek value does not match: expected χρωνια ωραια, got %s", greek)
+	}
+}
+
+func TestDefaultGreeklishPreferences(t *testing.T) {
+	// Every ambiguous spelling of the equivalence classes must have a default preference.
+	for r := 'a'; r <= 'z'; r++ {
+		for _, latin := range []string{string(r), string(r) + "h", string(r) + "s"} {
+			if candidates := greeklishCandidates(latin); len(candidates) > 1 {
+				if _, ok := DefaultGreeklishPreferences[latin]; !ok {
+					t.Errorf("(%s) No default preference between %v", latin, candidates)
+				}
+			}
+		}
+	}
+}
@@ -4,45 +4,64 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"unicode/utf8"
 )
 
 type (
 	SpeechSound struct {
-		Match                string
-		Group                string
-		EventualVowelsExist  bool
-		ImmediateVowelExists bool
-		ImmediateConsonants  int
+		Match                string `json:"match"`
+		Group                string `json:"group"`
+		EventualVowelsExist  bool   `json:"eventual_vowels_exist"`
+		ImmediateVowelExists bool   `json:"immediate_vowel_exists"`
+		ImmediateConsonants  int    `json:"immediate_consonants"`
 	}
 
 	Options struct {
-		Separator            string
-		MinHyphenationLength int
-		UseGrhyphRules       bool
-		CombineConsonantsDn  bool
-		CombineConsonantsKv  bool
-		CombineConsonantsPf  bool
-		CombineConsonantsSn  bool
-		CombineConsonantsFk  bool
-		QuickSynizesis       bool
+		Separator            string `json:"separator"`
+		MinHyphenationLength int    `json:"min_hyphenation_length"`
+		UseGrhyphRules       bool   `json:"use_grhyph_rules"`
+		CombineConsonantsDn  bool   `json:"combine_consonants_dn"`
+		CombineConsonantsKv  bool   `json:"combine_consonants_kv"`
+		CombineConsonantsPf  bool   `json:"combine_consonants_pf"`
+		CombineConsonantsSn  bool   `json:"combine_consonants_sn"`
+		CombineConsonantsFk  bool   `json:"combine_consonants_fk"`
+		QuickSynizesis       bool   `json:"quick_synizesis"`
+
+		// LeftMin and RightMin suppress breaks that would leave fewer letters at the start or the end of
+		// a word, as TeX's \lefthyphenmin and \righthyphenmin do. Values below 2 impose no restriction.
+		LeftMin  int `json:"left_min"`
+		RightMin int `json:"right_min"`
+
+		// RestoreAccents hyphenates Greek words as if their accents and diaeresis were restored by
+		// RestoreAccents, e.g. "ιδια" as "ίδια", which improves the synizesis decisions. The output keeps
+		// the letters of the input.
+		RestoreAccents bool `json:"restore_accents"`
 	}
 
+	// Hyphenation is a thin, single goroutine wrapper around a Hyphenator.
+	// SpeechSounds and WSCRe are filled in by Hyphenate, for inspection.
 	Hyphenation struct {
 		Input        string
 		Options      Options
 		SpeechSounds []SpeechSound
 		WSCRe        *regexp.Regexp // WordStartConsonantsRe may vary between Hyphenation instances.
+
+		hyphenator *Hyphenator
 	}
 
-	CacheKey struct {
-		HyphenationInput   string
-		HyphenationOptions Options // Have separate caches when options differ.
+	// Hyphenator holds everything that is resolved from an Options value: the word start consonants
+	// regexp, the rule set and a private Cache. It is never mutated after NewHyphenator returns, apart
+	// from its synchronized Cache, so a single instance may be shared between goroutines.
+	Hyphenator struct {
+		options Options
+		wSCRe   *regexp.Regexp
+		rules   []GrhyphRule
+		cache   Cache
+
+		separatorReplacer *strings.Replacer
 	}
 )
 
-var Cache = map[CacheKey]string{}
-var CachingEnabled bool = true
-
 var defaultOptions = Options{
 	Separator:            "/",
 	MinHyphenationLength: 2,
@@ -114,23 +133,153 @@ func stringTospeechSounds(s string) ([]SpeechSound, error) {
 	return speechSounds, nil
 }
 
+// NewHyphenator resolves the word start consonants regexp and the rule set for o. The Hyphenator caches
+// rule-hyphenated words in an LRUCache of DefaultCacheSize words.
+func NewHyphenator(o Options) (*Hyphenator, error) {
+	return NewHyphenatorWithCache(o, NewLRUCache(DefaultCacheSize))
+}
+
+// NewHyphenatorWithCache is like NewHyphenator, but uses c as the cache. Pass NoCache{} to disable caching.
+// When o.UseGrhyphRules is set, the errors of ValidateRules are returned.
+func NewHyphenatorWithCache(o Options, c Cache) (*Hyphenator, error) {
+	if o.UseGrhyphRules {
+		if err := ValidateRules(); err != nil {
+			return nil, err
+		}
+	}
+
+	return NewHyphenatorWithRules(o, GrhyphRules, c)
+}
+
+// NewHyphenatorWithRules is like NewHyphenatorWithCache, but matches words against rules, e.g. as returned
+// by LoadRulesFile, instead of the built-in GrhyphRules. A nil c defaults to an LRUCache.
+func NewHyphenatorWithRules(o Options, rules []GrhyphRule, c Cache) (*Hyphenator, error) {
+	wSCRe, err := compileWSCRe(WSCReMapKey{o.CombineConsonantsDn, o.CombineConsonantsKv,
+		o.CombineConsonantsPf, o.CombineConsonantsSn, o.CombineConsonantsFk})
+	if err != nil {
+		return nil, err
+	}
+
+	if c == nil {
+		c = NewLRUCache(DefaultCacheSize)
+	}
+
+	h := &Hyphenator{
+		options: o,
+		wSCRe:   wSCRe,
+		rules:   rules,
+		cache:   c,
+
+		separatorReplacer: breakMarksReplacer(o.Separator),
+	}
+
+	return h, nil
+}
+
+// Options returns the options the Hyphenator was created with.
+func (h *Hyphenator) Options() Options {
+	return h.options
+}
+
+// Cache returns the cache of the Hyphenator, e.g. to read the LRUCache statistics.
+func (h *Hyphenator) Cache() Cache {
+	return h.cache
+}
+
+// Hyphenate is safe to call from multiple goroutines.
+func (h *Hyphenator) Hyphenate(s string) string {
+	return h.render(h.markBreaks(s))
+}
+
+// markBreaks returns s with a break mark at every hyphenation point.
+func (h *Hyphenator) markBreaks(s string) string {
+	speechSounds, _ := stringTospeechSounds(s)
+
+	return h.markSpeechSoundBreaks(s, speechSounds)
+}
+
+// render replaces the break marks by the separator.
+func (h *Hyphenator) render(marked string) string {
+	return h.separatorReplacer.Replace(marked)
+}
+
+func (h *Hyphenator) markSpeechSoundBreaks(s string, speechSounds []SpeechSound) string {
+	return h.markRestoredBreaks(s, speechSounds, h.markAccentedBreaks)
+}
+
+// markRestoredBreaks marks the breaks of s by mark, on the accented form of s with Options.RestoreAccents.
+// The marked text keeps the letters of s.
+func (h *Hyphenator) markRestoredBreaks(s string, speechSounds []SpeechSound,
+	mark func(s string, speechSounds []SpeechSound) string) string {
+	if h.options.RestoreAccents {
+		if accented := accentWords(s, false); accented != s {
+			accentedSpeechSounds, _ := stringTospeechSounds(accented)
+
+			return withLettersOf(mark(accented, accentedSpeechSounds), s)
+		}
+	}
+
+	return mark(s, speechSounds)
+}
+
+func (h *Hyphenator) markAccentedBreaks(s string, speechSounds []SpeechSound) string {
+	var marked string
+	if !h.options.UseGrhyphRules {
+		marked = plainHyphenation(speechSounds, h.options, h.wSCRe)
+	} else {
+		marked = h.regexpHyphenation(s, speechSounds)
+	}
+
+	return h.hyphenMins(s, speechSounds, marked)
+}
+
+// withLettersOf replaces the letters of marked, the break marked form of a word spelled as s but for its
+// accents, by those of s.
+func withLettersOf(marked, s string) string {
+	var b strings.Builder
+
+	for _, r := range marked {
+		if _, ok := breakClassOf(r); ok {
+			b.WriteRune(r)
+			continue
+		}
+
+		letter, size := utf8.DecodeRuneInString(s)
+		b.WriteRune(letter)
+		s = s[size:]
+	}
+
+	return b.String()
+}
+
+// hyphenMins drops the marked breaks that Options.LeftMin and Options.RightMin do not allow.
+func (h *Hyphenator) hyphenMins(s string, speechSounds []SpeechSound, marked string) string {
+	if h.options.LeftMin > 1 || h.options.RightMin > 1 {
+		marked = applyHyphenMins(s, speechSounds, markedBreaks(marked), h.options.LeftMin, h.options.RightMin)
+	}
+
+	return marked
+}
+
 func (h *Hyphenation) Hyphenate() (string, error) {
+	if h.hyphenator == nil || h.hyphenator.options != h.Options {
+		hyphenator, err := NewHyphenator(h.Options)
+		if err != nil {
+			return "", err
+		}
+
+		h.hyphenator = hyphenator
+	}
+
 	speechSounds, err := stringTospeechSounds(h.Input)
 	if err != nil {
 		return "", err
 	}
 
 	h.SpeechSounds = speechSounds
-	h.WSCRe = GetWSCRe(h.Options.CombineConsonantsDn, h.Options.CombineConsonantsKv,
-		h.Options.CombineConsonantsPf, h.Options.CombineConsonantsSn, h.Options.CombineConsonantsFk)
+	h.WSCRe = h.hyphenator.wSCRe
 
-	if !h.Options.UseGrhyphRules {
-		return plainHyphenation(speechSounds, h.Options, h.WSCRe), nil
-	} else {
-		return h.regexpHyphenation(), nil
-	}
-
-	return "", nil
+	return h.hyphenator.render(h.hyphenator.markSpeechSoundBreaks(h.Input, speechSounds)), nil
 }
 
 var synizesisVowelsRe *regexp.Regexp = regexp.MustCompile(SynizesisVowelsRe)
@@ -149,7 +298,7 @@ func plainHyphenation(ss []SpeechSound, o Options, wSCRe *regexp.Regexp) string
 			continue
 		} else if ss[i].Group == "vowels" {
 			if ss[i].EventualVowelsExist && ss[i].ImmediateConsonants == 1 {
-				hyphenated = append(hyphenated, fmt.Sprintf("%s%s", ss[i].Match, o.Separator)...)
+				hyphenated = append(hyphenated, fmt.Sprintf("%s%s", ss[i].Match, breakMark)...)
 				continue
 			} else if ss[i].ImmediateConsonants >= 1 && !ss[i].EventualVowelsExist {
 				hyphenated = append(hyphenated, ss[i].Match...)
@@ -161,12 +310,16 @@ func plainHyphenation(ss []SpeechSound, o Options, wSCRe *regexp.Regexp) string
 				continue
 			} else if ss[i].ImmediateVowelExists {
 				// Flag for quick-synizesis / end-of-the-line hyphenation.
-				if o.QuickSynizesis &&
-					synizesisVowelsRe.MatchString(fmt.Sprintf("%s%s", ss[i].Match, ss[i+1].Match)) {
-					hyphenated = append(hyphenated, ss[i].Match...)
+				if synizesisVowelsRe.MatchString(fmt.Sprintf("%s%s", ss[i].Match, ss[i+1].Match)) {
+					if o.QuickSynizesis {
+						hyphenated = append(hyphenated, ss[i].Match...)
+						continue
+					}
+
+					hyphenated = append(hyphenated, fmt.Sprintf("%s%s", ss[i].Match, synizesisBreakMark)...)
 					continue
 				}
-				hyphenated = append(hyphenated, fmt.Sprintf("%s%s", ss[i].Match, o.Separator)...)
+				hyphenated = append(hyphenated, fmt.Sprintf("%s%s", ss[i].Match, breakMark)...)
 				continue
 			}
 		}
@@ -184,13 +337,13 @@ func consonantHyphenation(startIndex int, consonantsN int,
 	endIndex := startIndex + consonantsN
 	for i := startIndex; i < endIndex; i++ {
 		if i == endIndex-1 {
-			hyphenatedConsonants = append(hyphenatedConsonants, fmt.Sprintf("%s%s", o.Separator, ss[i].Match)...)
+			hyphenatedConsonants = append(hyphenatedConsonants, fmt.Sprintf("%s%s", consonantBreakMark, ss[i].Match)...)
 			break
 		}
 
 		consonantsPair := fmt.Sprintf("%s%s", ss[i].Match, ss[i+1].Match)
 		if wSCRe.MatchString(consonantsPair) {
-			hyphenatedConsonants = append(hyphenatedConsonants, o.Separator...)
+			hyphenatedConsonants = append(hyphenatedConsonants, consonantBreakMark...)
 			for ; i < endIndex; i++ {
 				hyphenatedConsonants = append(hyphenatedConsonants, ss[i].Match...)
 			}
@@ -204,34 +357,45 @@ func consonantHyphenation(startIndex int, consonantsN int,
 	return string(hyphenatedConsonants[:])
 }
 
-func (h *Hyphenation) regexpHyphenation() string {
-	if len(h.Input) <= 1 || len(h.Input) < h.Options.MinHyphenationLength {
-		return h.Input
+// rulesMatchInput tells whether regexpHyphenation matches the rules against the words of input at all.
+func (h *Hyphenator) rulesMatchInput(input string) bool {
+	return len(input) > 1 && len(input) >= h.options.MinHyphenationLength
+}
+
+// rulesMatchWord tells whether regexpHyphenation matches the rules against a word of n speech sounds that
+// punctuation follows. The last word of the input is matched regardless of its length.
+func (h *Hyphenator) rulesMatchWord(n int) bool {
+	return n > 1 && n >= h.options.MinHyphenationLength
+}
+
+func (h *Hyphenator) regexpHyphenation(input string, speechSounds []SpeechSound) string {
+	if !h.rulesMatchInput(input) {
+		return input
 	}
 
 	var hyphenated []byte
 
 	// Separate multiple input words by using the speechSound punctuation points.
 	lastPunctuationIndex := -1
-	for i, speechSounds := range h.SpeechSounds {
+	for i, speechSound := range speechSounds {
 		start := lastPunctuationIndex + 1
 		end := i - 1
-		isLastIteration := (i == len(h.SpeechSounds)-1)
+		isLastIteration := (i == len(speechSounds)-1)
 
-		if speechSounds.Group == "punctuation" {
+		if speechSound.Group == "punctuation" {
 			if start >= 0 && i-start > 1 {
-				if (i - start) >= h.Options.MinHyphenationLength {
-					hyphenated = append(hyphenated, regexpReplace(h.SpeechSounds[start:i], h.Options, h.WSCRe)...)
+				if h.rulesMatchWord(i - start) {
+					hyphenated = append(hyphenated, h.regexpReplace(speechSounds[start:i], nil)...)
 				} else {
-					hyphenated = append(hyphenated, speechSoundJoin(h.SpeechSounds[start:i])...)
+					hyphenated = append(hyphenated, speechSoundJoin(speechSounds[start:i])...)
 				}
 			} else if start >= 0 && end-start == 0 {
-				hyphenated = append(hyphenated, h.SpeechSounds[start].Match...)
+				hyphenated = append(hyphenated, speechSounds[start].Match...)
 			}
-			hyphenated = append(hyphenated, h.SpeechSounds[i].Match...)
+			hyphenated = append(hyphenated, speechSounds[i].Match...)
 			lastPunctuationIndex = i
 		} else if isLastIteration {
-			hyphenated = append(hyphenated, regexpReplace(h.SpeechSounds[start:], h.Options, h.WSCRe)...)
+			hyphenated = append(hyphenated, h.regexpReplace(speechSounds[start:], nil)...)
 		}
 	}
 
@@ -247,20 +411,21 @@ func speechSoundJoin(speechSounds []SpeechSound) string {
 	return string(joinedMatchesBytes[:])
 }
 
-func regexpReplace(speechSounds []SpeechSound, o Options, wSCRe *regexp.Regexp) string {
+// regexpReplace hyphenates a single word, by the first rule that matches it, or by plainHyphenation. When
+// trace is not nil, the cache is bypassed and every step is recorded in trace.
+func (h *Hyphenator) regexpReplace(speechSounds []SpeechSound, trace *Trace) string {
+	o := h.options
 	joinedSpeechSounds := speechSoundJoin(speechSounds)
 
-	if CachingEnabled {
-		cacheKey := CacheKey{joinedSpeechSounds, o}
-
-		if hyphenatedString, ok := Cache[cacheKey]; ok {
-			return hyphenatedString
-		}
+	if trace != nil {
+		trace.Input = joinedSpeechSounds
+	} else if hyphenatedString, ok := h.cache.Get(joinedSpeechSounds); ok {
+		return hyphenatedString
 	}
 
-	for _, rule := range GrhyphRules {
+	for ruleIndex, rule := range h.rules {
 		if rule.CompiledCustomRe.MatchString(joinedSpeechSounds) {
-			replacement := strings.Replace(rule.Replacement, "-", o.Separator, -1)
+			replacement := strings.Replace(rule.Replacement, "-", ruleBreakMark, -1)
 
 			var (
 				middleRunes      []byte
@@ -281,31 +446,46 @@ func regexpReplace(speechSounds []SpeechSound, o Options, wSCRe *regexp.Regexp)
 				}
 			}
 
+			var leftTrace, rightTrace *Trace
+			if trace != nil {
+				trace.Rule, trace.RuleIndex = &h.rules[ruleIndex], ruleIndex
+				trace.Left, trace.Right = h.render(toHyphenateLeft), h.render(toHyphenateRight)
+
+				if toHyphenateLeft != "" {
+					leftTrace = &Trace{}
+					trace.LeftTrace = leftTrace
+				}
+				if toHyphenateRight != "" {
+					rightTrace = &Trace{}
+					trace.RightTrace = rightTrace
+				}
+			}
+
 			leftSpeechSounds, _ := stringTospeechSounds(toHyphenateLeft)
-			toHyphenateLeft = regexpReplace(leftSpeechSounds, o, wSCRe)
+			toHyphenateLeft = h.regexpReplace(leftSpeechSounds, leftTrace)
 
 			rightSpeechSounds, _ := stringTospeechSounds(toHyphenateRight)
-			toHyphenateRight = regexpReplace(rightSpeechSounds, o, wSCRe)
+			toHyphenateRight = h.regexpReplace(rightSpeechSounds, rightTrace)
 
 			hyphenedMiddle := rule.CompiledCustomRe.ReplaceAllString(joinedSpeechSounds, string(middleRunes[:]))
 
-			// Debug:
-			// fmt.Println(rule)
-			// todo: Return the pre-compiled rules, if possible with a record of the line in which they were defined.
+			if trace != nil {
+				trace.Middle = h.render(hyphenedMiddle)
+				trace.Output = h.render(toHyphenateLeft + hyphenedMiddle + toHyphenateRight)
+			}
 
 			return toHyphenateLeft + hyphenedMiddle + toHyphenateRight
 		}
 	}
 
-	hyphened := plainHyphenation(speechSounds, o, wSCRe)
-
-	if CachingEnabled {
-		cacheKey := CacheKey{HyphenationInput: joinedSpeechSounds, HyphenationOptions: o}
+	hyphened := plainHyphenation(speechSounds, o, h.wSCRe)
 
-		if _, ok := Cache[cacheKey]; !ok {
-			Cache[cacheKey] = hyphened
-		}
+	if trace != nil {
+		trace.Output = h.render(hyphened)
+		return hyphened
 	}
 
+	h.cache.Put(joinedSpeechSounds, hyphened)
+
 	return hyphened
 }
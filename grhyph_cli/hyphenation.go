@@ -1,13 +1,24 @@
 package main
 
 import (
+	"bufio"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
 	"github.com/datio/grhyph"
 )
 
 func main() {
-	// minHyphenationLength := flag.Int("min-length", 2, "Minimum length of a word to hyphenate.")
+	minHyphenationLength := flag.Int("min-length", 2, "Minimum length of a word to hyphenate.")
+
+	leftMin := flag.Int("left-min", 0, "Minimum number of letters to leave at the start of a word.")
+
+	rightMin := flag.Int("right-min", 0, "Minimum number of letters to leave at the end of a word.")
 
 	disableCaching := flag.Bool("no-cache", false, "Disable caching when rule-hyphenating words.")
 
@@ -17,36 +28,435 @@ func main() {
 	separator := flag.String("separator", "­", `The separator to append between syllables.`)
 
 	useGrhyphRules := flag.Bool("use-rules", false, `Match and replace using rules, based on regular expressions,
-	 as defined in the definitions.go file.`)
+	 as defined in the grhyph.rules file.`)
+
+	explain := flag.Bool("explain", false, "Print which rules matched each word, and how it was split.")
+
+	rulesFile := flag.String("rules-file", "", "Load the rules from a file instead of the built-in grhyph.rules.")
+
+	latex := flag.Bool("latex", false, `Write LaTeX, with TeX special characters escaped and \- discretionary hyphens.`)
+
+	texExceptions := flag.String("tex-exceptions", "", `Write a TeX \hyphenation{} block for the words of a file
+	 ("-" for the standard input) that the rules hyphenate differently from plain syllabification.`)
+
+	patterns := flag.String("patterns", "", `Train Liang patterns on the words of a file ("-" for the standard
+	 input), and write them in the hyph-el TeX format. The accuracy report is written to the standard error.`)
+
+	patternLevels := flag.Int("pattern-levels", len(grhyph.DefaultPatternLevels), "Number of pattern levels to train.")
+
+	dic := flag.String("dic", "", `Train patterns on the words of a file ("-" for the standard input), and write
+	 them as a libhyphen dictionary, e.g. hyph_el_GR.dic.`)
+
+	greeklish := flag.Bool("greeklish", false, "Keep the Greeklish words of the -dic word list.")
+
+	checkDic := flag.String("check-dic", "", `Report the words, of the arguments or the standard input, that a
+	 libhyphen dictionary hyphenates differently.`)
+
+	format := flag.String("format", "text", `The output format: "text", "json", "ndjson" or "tsv". Except for text,
+	 each argument, or line of the standard input, becomes a record with its syllables, the options and,
+	 with -use-rules, the matched rules.`)
+
+	input := flag.String("input", "text", `The format of the standard input: "text", "html", "markdown" or "subtitles"
+	 (SRT or WebVTT).`)
+
+	lineLength := flag.Int("line-length", 42, "Maximum number of characters per line, for subtitles input.")
+
+	karaoke := flag.String("karaoke", "", `Write the cues of subtitles input as karaoke, timed per syllable: "ass" for
+	 {\k} tags, or "lrc" for enhanced LRC.`)
+
+	useWBR := flag.Bool("wbr", false, "Insert <wbr> elements instead of soft hyphens, for html input and EPUB.")
+
+	languages := flag.String("lang", "el", "Comma separated lang attribute values to hyphenate, for html input and EPUB.")
+
+	toGreek := flag.Bool("to-greek", false, "Transliterate the Greeklish of the arguments, or the standard input, to Greek.")
+
+	toLatin := flag.String("to-latin", "", `Romanize the arguments, or the standard input, hyphenated with the separator:
+	 "elot" for ELOT 743, "iso" for ISO 843 or "greeklish".`)
+
+	restoreAccents := flag.Bool("restore-accents", false, `Hyphenate Greek words as if their accents and diaeresis
+	 were restored from the lexicon, e.g. "τσαι" as "τσάι".`)
+
+	accents := flag.Bool("accents", false, `Restore the accents of the arguments, or the standard input, including
+	 their Greeklish words, instead of hyphenating.`)
+
+	// "grhyph_cli epub [flags] book.epub..." hyphenates e-books in place, with the html input flags, and
+	// "grhyph_cli office [flags] document.docx..." hyphenates DOCX and ODT documents in place.
+	var command string
+	if len(os.Args) > 1 && (os.Args[1] == "epub" || os.Args[1] == "office") {
+		command = os.Args[1]
+		os.Args = append(os.Args[:1], os.Args[2:]...)
+	}
 
 	flag.Parse()
 
-	grhyph.CachingEnabled = !*disableCaching
+	switch *input {
+	case "text", "html", "markdown", "subtitles":
+	default:
+		fmt.Println(fmt.Errorf("grhyph err:\nunknown input format %q", *input))
+		return
+	}
 
 	hyphenationOptions := grhyph.GetDefaultOptions()
 
-	// if *minHyphenationLength > 1 {
-	// hyphenationOptions.MinHyphenationLength = *minHyphenationLength
-	// }
+	if *minHyphenationLength > 1 {
+		hyphenationOptions.MinHyphenationLength = *minHyphenationLength
+	}
 
 	hyphenationOptions.QuickSynizesis = *quickSynizesis
 	hyphenationOptions.Separator = *separator
 	hyphenationOptions.UseGrhyphRules = *useGrhyphRules
+	hyphenationOptions.LeftMin = *leftMin
+	hyphenationOptions.RightMin = *rightMin
+	hyphenationOptions.RestoreAccents = *restoreAccents
 
-	h := grhyph.Hyphenation{
-		Options: hyphenationOptions,
+	var cache grhyph.Cache = grhyph.NewLRUCache(grhyph.DefaultCacheSize)
+	if *disableCaching {
+		cache = grhyph.NoCache{}
+	}
+
+	rules, err := grhyph.GrhyphRules, grhyph.ValidateRules()
+	if *rulesFile != "" {
+		rules, err = grhyph.LoadRulesFile(*rulesFile)
+	}
+	if err != nil {
+		fmt.Println(fmt.Errorf("grhyph err:\n%v", err))
+		return
+	}
+
+	h, err := grhyph.NewHyphenatorWithRules(hyphenationOptions, rules, cache)
+	if err != nil {
+		fmt.Println(fmt.Errorf("grhyph err:\n%v", err))
+		return
 	}
 
 	inputs := flag.Args()
-	for _, input := range inputs {
-		h.Input = input
 
-		hyphenedText, err := h.Hyphenate()
+	if *toLatin != "" {
+		schemes := map[string]grhyph.LatinScheme{
+			"elot":      grhyph.ELOT743,
+			"iso":       grhyph.ISO843,
+			"greeklish": grhyph.CommonGreeklish,
+		}
+
+		scheme, ok := schemes[*toLatin]
+		if !ok {
+			fmt.Println(fmt.Errorf("grhyph err:\nunknown romanization %q", *toLatin))
+			return
+		}
+
+		if len(inputs) == 0 {
+			inputs, err = readLines(os.Stdin)
+		}
 		if err != nil {
 			fmt.Println(fmt.Errorf("grhyph err:\n%v", err))
 			return
 		}
 
-		fmt.Println(hyphenedText)
+		for _, input := range inputs {
+			fmt.Println(h.HyphenateToLatin(input, scheme))
+		}
+		return
 	}
+
+	if *accents {
+		if len(inputs) == 0 {
+			inputs, err = readLines(os.Stdin)
+		}
+		if err != nil {
+			fmt.Println(fmt.Errorf("grhyph err:\n%v", err))
+			return
+		}
+
+		for _, input := range inputs {
+			fmt.Println(grhyph.AccentText(input))
+		}
+		return
+	}
+
+	if *toGreek {
+		if len(inputs) == 0 {
+			inputs, err = readLines(os.Stdin)
+		}
+		if err != nil {
+			fmt.Println(fmt.Errorf("grhyph err:\n%v", err))
+			return
+		}
+
+		for _, input := range inputs {
+			fmt.Println(grhyph.ToGreek(input, grhyph.GreeklishOptions{}))
+		}
+		return
+	}
+
+	if *texExceptions != "" {
+		words, err := readWords(*texExceptions)
+		if err == nil {
+			err = h.WriteHyphenationExceptions(os.Stdout, words)
+		}
+		if err != nil {
+			fmt.Println(fmt.Errorf("grhyph err:\n%v", err))
+		}
+		return
+	}
+
+	if *patternLevels < 1 || *patternLevels > len(grhyph.DefaultPatternLevels) {
+		fmt.Println(fmt.Errorf("grhyph err:\n-pattern-levels must be between 1 and %d", len(grhyph.DefaultPatternLevels)))
+		return
+	}
+
+	if *patterns != "" {
+		words, err := readWords(*patterns)
+		if err != nil {
+			fmt.Println(fmt.Errorf("grhyph err:\n%v", err))
+			return
+		}
+
+		p, report := h.GeneratePatterns(words, grhyph.DefaultPatternLevels[:*patternLevels])
+		fmt.Fprint(os.Stderr, report)
+		if err := p.WriteTeX(os.Stdout); err != nil {
+			fmt.Println(fmt.Errorf("grhyph err:\n%v", err))
+		}
+		return
+	}
+
+	if *dic != "" {
+		words, err := readWords(*dic)
+		if err != nil {
+			fmt.Println(fmt.Errorf("grhyph err:\n%v", err))
+			return
+		}
+
+		dicOptions := grhyph.DicOptions{Levels: grhyph.DefaultPatternLevels[:*patternLevels], Greeklish: *greeklish}
+
+		report, err := h.WriteDic(os.Stdout, words, dicOptions)
+		if err != nil {
+			fmt.Println(fmt.Errorf("grhyph err:\n%v", err))
+			return
+		}
+		fmt.Fprint(os.Stderr, report)
+		return
+	}
+
+	if *checkDic != "" {
+		words := flag.Args()
+		if len(words) == 0 {
+			words, err = readWords("-")
+		}
+
+		var p *grhyph.Patterns
+		if err == nil {
+			var f *os.File
+			if f, err = os.Open(*checkDic); err == nil {
+				p, err = grhyph.ReadDic(f)
+				f.Close()
+			}
+		}
+		if err != nil {
+			fmt.Println(fmt.Errorf("grhyph err:\n%v", err))
+			return
+		}
+
+		mismatches := p.Check(h, words)
+		for _, mismatch := range mismatches {
+			fmt.Printf("%s: expected %s, got %s\n", mismatch.Word, mismatch.Expected, mismatch.Got)
+		}
+		fmt.Printf("%d mismatches\n", len(mismatches))
+		return
+	}
+
+	// The html input and the epub command hyphenate with the rules and the cache of h, as the office command
+	// and the markdown input do.
+	htmlOptions := grhyph.HTMLOptions{
+		Options:   hyphenationOptions,
+		UseWBR:    *useWBR,
+		Languages: strings.Split(*languages, ","),
+		Rules:     rules,
+		Cache:     cache,
+	}
+
+	if command != "" {
+		for _, name := range flag.Args() {
+			var err error
+			if command == "epub" {
+				err = grhyph.EPUBHyphenateFile(name, htmlOptions)
+			} else {
+				err = h.HyphenateOfficeFile(name)
+			}
+			if err != nil {
+				fmt.Println(fmt.Errorf("grhyph err:\n%s: %v", name, err))
+			}
+		}
+		return
+	}
+
+	if *input == "html" {
+		if err := grhyph.HTMLHyphenate(os.Stdin, os.Stdout, htmlOptions); err != nil {
+			fmt.Println(fmt.Errorf("grhyph err:\n%v", err))
+		}
+		return
+	}
+
+	if *input == "subtitles" {
+		s, err := grhyph.ParseSubtitles(os.Stdin)
+		if err == nil {
+			switch *karaoke {
+			case "":
+				h.WrapSubtitles(s, *lineLength)
+				err = s.Write(os.Stdout)
+			case "ass":
+				err = h.WriteASS(os.Stdout, s.KaraokeLines())
+			case "lrc":
+				err = h.WriteLRC(os.Stdout, s.KaraokeLines())
+			default:
+				err = fmt.Errorf("unknown karaoke format %q", *karaoke)
+			}
+		}
+		if err != nil {
+			fmt.Println(fmt.Errorf("grhyph err:\n%v", err))
+		}
+		return
+	}
+
+	if *input == "markdown" {
+		source, err := io.ReadAll(os.Stdin)
+		if err == nil {
+			_, err = os.Stdout.Write(h.HyphenateMarkdown(source))
+		}
+		if err != nil {
+			fmt.Println(fmt.Errorf("grhyph err:\n%v", err))
+		}
+		return
+	}
+
+	if *format != "text" {
+		if len(inputs) == 0 {
+			inputs, err = readLines(os.Stdin)
+		}
+		if err == nil {
+			err = writeResults(os.Stdout, h, inputs, *format)
+		}
+		if err != nil {
+			fmt.Println(fmt.Errorf("grhyph err:\n%v", err))
+		}
+		return
+	}
+
+	if *latex && len(inputs) == 0 {
+		text, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			fmt.Println(fmt.Errorf("grhyph err:\n%v", err))
+			return
+		}
+
+		fmt.Print(h.HyphenateLaTeX(string(text)))
+		return
+	}
+
+	// Without arguments, hyphenate the standard input as it streams.
+	if len(inputs) == 0 && !*explain {
+		if _, err := h.Copy(os.Stdout, os.Stdin); err != nil {
+			fmt.Println(fmt.Errorf("grhyph err:\n%v", err))
+		}
+		return
+	}
+
+	for _, input := range inputs {
+		if *explain {
+			for _, trace := range h.ExplainText(input) {
+				fmt.Print(trace)
+			}
+			continue
+		}
+
+		if *latex {
+			fmt.Println(h.HyphenateLaTeX(input))
+			continue
+		}
+
+		fmt.Println(h.Hyphenate(input))
+	}
+}
+
+// readWords returns the whitespace separated words of a file, or of the standard input for "-".
+func readWords(path string) ([]string, error) {
+	var (
+		content []byte
+		err     error
+	)
+
+	if path == "-" {
+		content, err = io.ReadAll(os.Stdin)
+	} else {
+		content, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Fields(string(content)), nil
+}
+
+// readLines returns the lines of r.
+func readLines(r io.Reader) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	return lines, scanner.Err()
+}
+
+var tsvEscaper = strings.NewReplacer("\\", "\\\\", "\t", "\\t", "\n", "\\n", "\r", "\\r")
+
+// writeResults writes the results of the inputs as a JSON array, as newline delimited JSON, or as
+// tab-separated values: the input, the output, the syllables separated by "|", and the matched rule indexes.
+func writeResults(w io.Writer, h *grhyph.Hyphenator, inputs []string, format string) error {
+	results := make([]grhyph.Result, len(inputs))
+	for i, input := range inputs {
+		results[i] = h.Result(input)
+	}
+
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(results)
+
+	case "ndjson":
+		encoder := json.NewEncoder(w)
+		for _, result := range results {
+			if err := encoder.Encode(result); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case "tsv":
+		bw := bufio.NewWriter(w)
+		bw.WriteString("input\toutput\tsyllables\trules\n")
+
+		for _, result := range results {
+			syllables := make([]string, len(result.Syllables))
+			for i, syllable := range result.Syllables {
+				syllables[i] = syllable.Text
+			}
+
+			rules := make([]string, len(result.Rules))
+			for i, rule := range result.Rules {
+				rules[i] = strconv.Itoa(rule.Index)
+			}
+
+			fields := []string{result.Input, result.Output, strings.Join(syllables, "|"), strings.Join(rules, ",")}
+			for i, field := range fields {
+				fields[i] = tsvEscaper.Replace(field)
+			}
+
+			bw.WriteString(strings.Join(fields, "\t") + "\n")
+		}
+		return bw.Flush()
+	}
+
+	return fmt.Errorf("unknown format %q", format)
 }
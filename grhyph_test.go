@@ -1,6 +1,7 @@
 package grhyph
 
 import (
+	"sync"
 	"testing"
 )
 
@@ -338,6 +339,42 @@ func TestConsonantCombinations(t *testing.T) {
 	}
 }
 
+func TestHyphenatorConcurrency(t *testing.T) {
+	hyphenationOptions := GetDefaultOptions()
+
+	hyphenationOptions.Separator = "-"
+	hyphenationOptions.UseGrhyphRules = true
+
+	h, err := NewHyphenator(hyphenationOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []hyphenationTest{
+		{"αλκμιόνη", "αλκ-μι-ό-νη"},
+		{"αηδόνια", "αη-δό-νια"},
+		{"anthia", "an-thia"},
+		{"παλιόπαλιοpalio", "πα-λιό-πα-λιο-pa-lio"},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 50; j++ {
+				for _, test := range tests {
+					if hyphenedText := h.Hyphenate(test.input); hyphenedText != test.hyphenated {
+						t.Errorf("(%s) Hyphenated value does not match: expected %s, got %s", test.input, test.hyphenated, hyphenedText)
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
 func BenchmarkPlain(b *testing.B) {
 	hyphenationOptions := GetDefaultOptions()
 
@@ -393,19 +430,13 @@ func BenchmarkRules(b *testing.B) {
 	hyphenationOptions.Separator = "-"
 	hyphenationOptions.UseGrhyphRules = true
 
-	h := Hyphenation{
-		Options: hyphenationOptions,
+	h, err := NewHyphenator(hyphenationOptions)
+	if err != nil {
+		panic(err)
 	}
 
-	CachingEnabled = true
-
 	for i := 0; i < b.N; i++ {
-		h.Input = "παλιόπαλιοpalio"
-
-		hyphenedText, err := h.Hyphenate()
-		if err != nil {
-			panic(err)
-		}
+		hyphenedText := h.Hyphenate("παλιόπαλιοpalio")
 
 		if hyphenedText != "πα-λιό-πα-λιο-pa-lio" {
 			b.Errorf("(%s) Hyphenated value does not match: expected %s, got %s", "παλιόπαλιοpalio", "πα-λιό-πα-λιο-pa-lio",
@@ -420,19 +451,13 @@ func BenchmarkRulesNoCache(b *testing.B) {
 	hyphenationOptions.Separator = "-"
 	hyphenationOptions.UseGrhyphRules = true
 
-	h := Hyphenation{
-		Options: hyphenationOptions,
+	h, err := NewHyphenatorWithCache(hyphenationOptions, NoCache{})
+	if err != nil {
+		panic(err)
 	}
 
-	CachingEnabled = false
-
 	for i := 0; i < b.N; i++ {
-		h.Input = "παλιόπαλιοpalio"
-
-		hyphenedText, err := h.Hyphenate()
-		if err != nil {
-			panic(err)
-		}
+		hyphenedText := h.Hyphenate("παλιόπαλιοpalio")
 
 		if hyphenedText != "πα-λιό-πα-λιο-pa-lio" {
 			b.Errorf("(%s) Hyphenated value does not match: expected %s, got %s", "παλιόπαλιοpalio", "πα-λιό-πα-λιο-pa-lio",
@@ -0,0 +1,323 @@
+package grhyph
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// HTMLOptions configures HTMLHyphenate. The Separator of the embedded Options is ignored.
+type HTMLOptions struct {
+	Options
+
+	// UseWBR inserts <wbr> elements instead of &shy; entities.
+	UseWBR bool
+
+	// XHTML writes the separator as XML, i.e. &#173; or <wbr/>, since XML does not define &shy;.
+	XHTML bool
+
+	// Languages lists the lang attribute values whose text is hyphenated, each also matching its subtags
+	// (e.g. "el" matches "el-GR"). Greeklish may be included by its tag, e.g. "el-Latn". Defaults to "el".
+	Languages []string
+
+	// DefaultLanguage is the language of text outside any lang attribute. Defaults to "el".
+	DefaultLanguage string
+
+	// SkipElements are the elements whose content is never hyphenated. Defaults to DefaultHTMLSkipElements.
+	SkipElements []string
+
+	// Rules, if not nil, are matched instead of the built-in GrhyphRules, as by NewHyphenatorWithRules.
+	Rules []GrhyphRule
+
+	// Cache caches the rule-hyphenated words, as by NewHyphenatorWithCache. Defaults to an LRUCache.
+	Cache Cache
+}
+
+var DefaultHTMLSkipElements = []string{"code", "pre", "script", "style", "textarea", "kbd", "samp", "var",
+	"title"}
+
+// htmlInlineElements are the elements that may split a word, e.g. "<b>Καλη</b>μέρα", whose tags do not
+// separate the text around them.
+var htmlInlineElements = map[string]bool{
+	"a": true, "abbr": true, "b": true, "bdi": true, "bdo": true, "cite": true, "data": true, "del": true,
+	"dfn": true, "em": true, "font": true, "i": true, "ins": true, "mark": true, "q": true, "s": true,
+	"small": true, "span": true, "strong": true, "sub": true, "sup": true, "time": true, "u": true,
+}
+
+type htmlElement struct {
+	name string
+	lang string
+	skip bool
+}
+
+var (
+	// Character references are kept as they are, and are not hyphenated.
+	htmlEntityRe = regexp.MustCompile(`&(?:#[0-9]+|#[xX][0-9a-fA-F]+|[a-zA-Z][a-zA-Z0-9]*);?`)
+	urlRe        = regexp.MustCompile(`(?i)(?:[a-z][a-z0-9+.-]*://|www\.|mailto:)\S+|\S+@\S+\.\S+`)
+)
+
+// HTMLHyphenate copies the HTML document read from r to w, inserting soft hyphens into the text nodes only.
+// Words split by inline elements, e.g. "<b>Καλη</b>μέρα", are hyphenated as a whole, and each soft hyphen is
+// written right after the letter before it. Markup, attribute values, character references and URLs are
+// written exactly as read.
+func HTMLHyphenate(r io.Reader, w io.Writer, o HTMLOptions) error {
+	h, err := NewHTMLHyphenator(o)
+	if err != nil {
+		return err
+	}
+
+	return h.Hyphenate(r, w)
+}
+
+// HTMLHyphenator is the reusable counterpart of HTMLHyphenate.
+type HTMLHyphenator struct {
+	h            *Hyphenator
+	languages    []string
+	defaultLang  string
+	skipElements map[string]bool
+}
+
+func NewHTMLHyphenator(o HTMLOptions) (*HTMLHyphenator, error) {
+	switch {
+	case o.UseWBR && o.XHTML:
+		o.Separator = "<wbr/>"
+	case o.UseWBR:
+		o.Separator = "<wbr>"
+	case o.XHTML:
+		o.Separator = "&#173;"
+	default:
+		o.Separator = "&shy;"
+	}
+
+	var (
+		h   *Hyphenator
+		err error
+	)
+	if o.Rules != nil {
+		h, err = NewHyphenatorWithRules(o.Options, o.Rules, o.Cache)
+	} else {
+		h, err = NewHyphenatorWithCache(o.Options, o.Cache)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hh := &HTMLHyphenator{
+		h:            h,
+		languages:    o.Languages,
+		defaultLang:  o.DefaultLanguage,
+		skipElements: map[string]bool{},
+	}
+
+	if len(hh.languages) == 0 {
+		hh.languages = []string{"el"}
+	}
+	if hh.defaultLang == "" {
+		hh.defaultLang = "el"
+	}
+
+	skipElements := o.SkipElements
+	if skipElements == nil {
+		skipElements = DefaultHTMLSkipElements
+	}
+	for _, name := range skipElements {
+		hh.skipElements[strings.ToLower(name)] = true
+	}
+
+	return hh, nil
+}
+
+// Hyphenate copies the HTML document read from r to w, as HTMLHyphenate does.
+func (hh *HTMLHyphenator) Hyphenate(r io.Reader, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	z := html.NewTokenizer(r)
+
+	var (
+		stack []htmlElement
+		run   htmlRun
+	)
+
+	for {
+		tokenType := z.Next()
+
+		switch tokenType {
+		case html.ErrorToken:
+			if err := z.Err(); err != io.EOF {
+				return err
+			}
+			run.flush(bw, hh.h)
+			return bw.Flush()
+
+		case html.TextToken:
+			if hh.hyphenateText(stack) {
+				run.addProse(z.Raw())
+			} else {
+				run.addBoundary(z.Raw())
+			}
+			continue
+
+		case html.StartTagToken:
+			raw := string(z.Raw()) // TagName lowercases the name in place.
+			name, hasAttr := z.TagName()
+			element := htmlElement{name: string(name)}
+
+			if len(stack) > 0 {
+				element.lang, element.skip = stack[len(stack)-1].lang, stack[len(stack)-1].skip
+			}
+			element.skip = element.skip || hh.skipElements[element.name]
+
+			for hasAttr {
+				var key, value []byte
+				key, value, hasAttr = z.TagAttr()
+				if string(key) == "lang" || string(key) == "xml:lang" {
+					element.lang = strings.ToLower(strings.TrimSpace(string(value)))
+				}
+			}
+
+			if !isVoidElement(element.name) {
+				stack = append(stack, element)
+			}
+
+			hh.writeTag(bw, &run, element.name, raw)
+			continue
+
+		case html.EndTagToken:
+			raw := string(z.Raw())
+			name, _ := z.TagName()
+			for i := len(stack) - 1; i >= 0; i-- {
+				if stack[i].name == string(name) {
+					stack = stack[:i]
+					break
+				}
+			}
+
+			hh.writeTag(bw, &run, string(name), raw)
+			continue
+		}
+
+		run.flush(bw, hh.h)
+		bw.Write(z.Raw())
+	}
+}
+
+// writeTag adds the tag of an inline element to run, or else writes it after the hyphenated run.
+func (hh *HTMLHyphenator) writeTag(w *bufio.Writer, run *htmlRun, name, raw string) {
+	if htmlInlineElements[name] && !hh.skipElements[name] {
+		run.raw = append(run.raw, raw...)
+		return
+	}
+
+	run.flush(w, hh.h)
+	w.WriteString(raw)
+}
+
+// htmlRun is a run of text nodes and inline elements, that is hyphenated as a whole, as officeParagraph is.
+// Character references, URLs and the text that is not hyphenated separate the words around them.
+type htmlRun struct {
+	raw     []byte // The markup and text of the run, as read.
+	text    []byte
+	offsets []int // The offset into raw of each byte of text, or -1 for word boundaries.
+}
+
+func (run *htmlRun) addBoundary(raw []byte) {
+	run.raw = append(run.raw, raw...)
+	run.text = append(run.text, ' ')
+	run.offsets = append(run.offsets, -1)
+}
+
+// addProse adds raw text to be hyphenated, except for its URLs and character references.
+func (run *htmlRun) addProse(raw []byte) {
+	last := 0
+	for _, loc := range urlRe.FindAllIndex(raw, -1) {
+		run.addEntityText(raw[last:loc[0]])
+		run.addBoundary(raw[loc[0]:loc[1]])
+		last = loc[1]
+	}
+
+	run.addEntityText(raw[last:])
+}
+
+func (run *htmlRun) addEntityText(raw []byte) {
+	last := 0
+	for _, loc := range htmlEntityRe.FindAllIndex(raw, -1) {
+		run.addText(raw[last:loc[0]])
+		run.addBoundary(raw[loc[0]:loc[1]])
+		last = loc[1]
+	}
+
+	run.addText(raw[last:])
+}
+
+func (run *htmlRun) addText(raw []byte) {
+	for _, b := range raw {
+		run.offsets = append(run.offsets, len(run.raw))
+		run.text = append(run.text, b)
+		run.raw = append(run.raw, b)
+	}
+}
+
+// flush writes the run to w, with the separator of h right after the letter before each break, or else right
+// before the letter after it, and empties it.
+func (run *htmlRun) flush(w *bufio.Writer, h *Hyphenator) {
+	last := 0
+	for _, b := range h.BreakPoints(string(run.text)) {
+		i := b.Position
+
+		var offset int
+		switch {
+		case i > 0 && run.offsets[i-1] >= 0:
+			offset = run.offsets[i-1] + 1
+		case i < len(run.text) && run.offsets[i] >= 0:
+			offset = run.offsets[i]
+		default:
+			continue
+		}
+
+		w.Write(run.raw[last:offset])
+		w.WriteString(h.options.Separator)
+		last = offset
+	}
+	w.Write(run.raw[last:])
+
+	run.raw, run.text, run.offsets = run.raw[:0], run.text[:0], run.offsets[:0]
+}
+
+// hyphenateText tells whether text within the open elements of stack is to be hyphenated.
+func (hh *HTMLHyphenator) hyphenateText(stack []htmlElement) bool {
+	lang := hh.defaultLang
+	if len(stack) > 0 {
+		if stack[len(stack)-1].skip {
+			return false
+		}
+		if stack[len(stack)-1].lang != "" {
+			lang = stack[len(stack)-1].lang
+		}
+	}
+
+	return matchLanguage(lang, hh.languages)
+}
+
+// matchLanguage tells whether lang is one of languages, or one of their subtags.
+func matchLanguage(lang string, languages []string) bool {
+	for _, language := range languages {
+		language = strings.ToLower(language)
+		if lang == language || strings.HasPrefix(lang, language+"-") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isVoidElement(name string) bool {
+	switch name {
+	case "area", "base", "br", "col", "embed", "hr", "img", "input", "link", "meta", "param", "source",
+		"track", "wbr":
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,81 @@
+package grhyph
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type htmlHyphenationTest struct {
+	input      string
+	hyphenated string
+}
+
+func TestHTMLHyphenate(t *testing.T) {
+	tests := []htmlHyphenationTest{
+		{`<p class="καλημέρα">καλημέρα</p>`, `<p class="καλημέρα">κα&shy;λη&shy;μέ&shy;ρα</p>`},
+		{`<P>Καλημέρα &amp; καληνύχτα&nbsp;κόσμε</P>`, `<P>Κα&shy;λη&shy;μέ&shy;ρα &amp; κα&shy;λη&shy;νύ&shy;χτα&nbsp;κό&shy;σμε</P>`},
+		{`<p>καλημέρα <code>καλημέρα</code> <pre><b>καλημέρα</b></pre> καλημέρα</p>`, `<p>κα&shy;λη&shy;μέ&shy;ρα <code>καλημέρα</code> <pre><b>καλημέρα</b></pre> κα&shy;λη&shy;μέ&shy;ρα</p>`},
+		{`<script>var s = "καλημέρα";</script><style>/* καλημέρα */</style>`, `<script>var s = "καλημέρα";</script><style>/* καλημέρα */</style>`},
+		{`<div lang="en">kalimera <span lang="el-GR">καλημέρα</span><br>kalimera</div>`, `<div lang="en">kalimera <span lang="el-GR">κα&shy;λη&shy;μέ&shy;ρα</span><br>kalimera</div>`},
+		{`<p>δες το www.example.gr/καλημέρα ή γράψε στο info@example.gr</p>`, `<p>δες το www.example.gr/καλημέρα ή γρά&shy;ψε στο info@example.gr</p>`},
+		{`<!-- καλημέρα --><img alt="καλημέρα"/>καλημέρα`, `<!-- καλημέρα --><img alt="καλημέρα"/>κα&shy;λη&shy;μέ&shy;ρα`},
+		{`<p><b>Καλη</b>μέρα, <a href="#"><em>καλη</em>νύχτα</a></p>`, `<p><b>Κα&shy;λη&shy;</b>μέ&shy;ρα, <a href="#"><em>κα&shy;λη&shy;</em>νύ&shy;χτα</a></p>`},
+		{`<p>καλη<br>μέρα<span lang="en">kalimera</span>καλημέρα</p>`, `<p>κα&shy;λη<br>μέ&shy;ρα<span lang="en">kalimera</span>κα&shy;λη&shy;μέ&shy;ρα</p>`},
+		{`<head><title>Καλημέρα</title></head>`, `<head><title>Καλημέρα</title></head>`},
+	}
+
+	for _, test := range tests {
+		var b bytes.Buffer
+		if err := HTMLHyphenate(strings.NewReader(test.input), &b, HTMLOptions{Options: GetDefaultOptions()}); err != nil {
+			t.Fatal(err)
+		}
+
+		if b.String() != test.hyphenated {
+			t.Errorf("(%s) Hyphenated value does not match: expected %s, got %s", test.input, test.hyphenated, b.String())
+		}
+	}
+}
+
+func TestHTMLHyphenateOptions(t *testing.T) {
+	input := `<p lang="el-Latn">kalimera</p><p lang="en">kalimera</p><p>καλημέρα</p>`
+
+	o := HTMLOptions{
+		Options:         GetDefaultOptions(),
+		UseWBR:          true,
+		Languages:       []string{"el-Latn"},
+		DefaultLanguage: "und",
+	}
+
+	expected := `<p lang="el-Latn">ka<wbr>li<wbr>me<wbr>ra</p><p lang="en">kalimera</p><p>καλημέρα</p>`
+
+	var b bytes.Buffer
+	if err := HTMLHyphenate(strings.NewReader(input), &b, o); err != nil {
+		t.Fatal(err)
+	}
+
+	if b.String() != expected {
+		t.Errorf("(%s) Hyphenated value does not match: expected %s, got %s", input, expected, b.String())
+	}
+}
+
+func TestHTMLHyphenateRules(t *testing.T) {
+	rules, err := LoadRules(strings.NewReader("(.*) (κ) (ό) (σ) (μ) (ε) (.*) => $1$2$3$4$5>-<$6$7\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o := HTMLOptions{Options: GetDefaultOptions(), Rules: rules, Cache: NoCache{}}
+	o.UseGrhyphRules = true
+
+	input, expected := "<p>κόσμε</p>", "<p>κόσμ&shy;ε</p>"
+
+	var b bytes.Buffer
+	if err := HTMLHyphenate(strings.NewReader(input), &b, o); err != nil {
+		t.Fatal(err)
+	}
+
+	if b.String() != expected {
+		t.Errorf("(%s) Hyphenated value does not match: expected %s, got %s", input, expected, b.String())
+	}
+}
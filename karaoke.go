@@ -0,0 +1,244 @@
+package grhyph
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var ErrSyllableCount = errors.New("the durations do not match the syllables")
+
+type (
+	// KaraokeLine is a lyric line, and its timing.
+	KaraokeLine struct {
+		Text  string
+		Start time.Duration
+		End   time.Duration
+
+		// Durations optionally times every syllable, in order. When nil, the time from Start to End is
+		// distributed evenly across the syllables. End defaults to the end of the last syllable.
+		Durations []time.Duration
+	}
+
+	// KaraokeSyllable is a sung syllable. Its Text includes the whitespace and punctuation that follow it,
+	// so that the syllables of a line add up to the whole line.
+	KaraokeSyllable struct {
+		Text     string
+		Start    time.Duration
+		Duration time.Duration
+	}
+)
+
+// KaraokeSyllables splits a line into the syllables that are sung, and times them. Vowels that are prone to
+// synizesis, i.e. the breaks of class SynizesisBreak, are sung as a single syllable. Times are rounded to
+// centiseconds, the precision of ASS and LRC.
+func (h *Hyphenator) KaraokeSyllables(line KaraokeLine) ([]KaraokeSyllable, error) {
+	texts := h.karaokeTexts(line.Text)
+
+	if line.Durations != nil && len(line.Durations) != len(texts) {
+		return nil, fmt.Errorf("%w: %d durations for %d syllables of %q", ErrSyllableCount, len(line.Durations),
+			len(texts), line.Text)
+	}
+
+	// The boundaries of the syllables are rounded, rather than their durations, so that rounding errors
+	// do not add up.
+	ends := make([]time.Duration, len(texts))
+	for i := range texts {
+		if line.Durations != nil {
+			ends[i] = line.Start
+			if i > 0 {
+				ends[i] = ends[i-1]
+			}
+			ends[i] += line.Durations[i]
+		} else {
+			ends[i] = line.Start + (line.End-line.Start)*time.Duration(i+1)/time.Duration(len(texts))
+		}
+	}
+
+	syllables := make([]KaraokeSyllable, len(texts))
+	start := line.Start.Round(10 * time.Millisecond)
+	for i, text := range texts {
+		end := ends[i].Round(10 * time.Millisecond)
+		syllables[i] = KaraokeSyllable{Text: text, Start: start, Duration: end - start}
+		start = end
+	}
+
+	return syllables, nil
+}
+
+// KaraokeLines returns a karaoke line for each cue of s, timed by the cue and with its lines of text, except
+// for their markup.
+func (s *Subtitles) KaraokeLines() []KaraokeLine {
+	var lines []KaraokeLine
+
+	for _, cue := range s.Cues {
+		if cue.Block != "" {
+			continue
+		}
+
+		lines = append(lines, KaraokeLine{
+			Text:  subtitleTagRe.ReplaceAllString(strings.Join(cue.Lines, "\n"), ""),
+			Start: cue.Start,
+			End:   cue.End,
+		})
+	}
+
+	return lines
+}
+
+// karaokeTexts splits s at its breaks, except for synizesis, and at the starts of its words. Whitespace and
+// punctuation are kept with the syllable before them, or with the first syllable at the start of s.
+func (h *Hyphenator) karaokeTexts(s string) []string {
+	starts := map[int]bool{}
+	for _, b := range h.BreakPoints(s) {
+		if b.Class != SynizesisBreak {
+			starts[b.Position] = true
+		}
+	}
+
+	var (
+		texts      []string
+		last       int
+		inWord     bool
+		hasLetters bool
+	)
+
+	for i, r := range s {
+		boundary := isWordBoundary(r)
+		if !boundary && !inWord && hasLetters {
+			starts[i] = true
+		}
+		inWord = !boundary
+		hasLetters = hasLetters || !boundary
+
+		if starts[i] && i > last {
+			texts = append(texts, s[last:i])
+			last = i
+		}
+	}
+
+	if last < len(s) {
+		texts = append(texts, s[last:])
+	}
+
+	return texts
+}
+
+// assEscaper escapes the characters of ASS override blocks and line breaks.
+var assEscaper = strings.NewReplacer(`\`, `\\`, "{", `\{`, "}", `\}`, "\r\n", `\N`, "\n", `\N`)
+
+// ASSKaraoke returns the text of an ASS Dialogue event for line, each syllable preceded by a {\kNN} tag of
+// its duration in centiseconds.
+func (h *Hyphenator) ASSKaraoke(line KaraokeLine) (string, error) {
+	syllables, err := h.KaraokeSyllables(line)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	for _, syllable := range syllables {
+		fmt.Fprintf(&b, `{\k%d}%s`, syllable.Duration.Milliseconds()/10, assEscaper.Replace(syllable.Text))
+	}
+
+	return b.String(), nil
+}
+
+// WriteASS writes an ASS script with a karaoke Dialogue event for each line, in a default style.
+func (h *Hyphenator) WriteASS(w io.Writer, lines []KaraokeLine) error {
+	bw := bufio.NewWriter(w)
+
+	bw.WriteString("[Script Info]\nScriptType: v4.00+\nWrapStyle: 0\n\n")
+	bw.WriteString("[V4+ Styles]\n")
+	bw.WriteString("Format: Name, Fontname, Fontsize, PrimaryColour, SecondaryColour, OutlineColour, BackColour, " +
+		"Bold, Italic, Underline, StrikeOut, ScaleX, ScaleY, Spacing, Angle, BorderStyle, Outline, Shadow, " +
+		"Alignment, MarginL, MarginR, MarginV, Encoding\n")
+	bw.WriteString("Style: Default,Arial,48,&H00FFFFFF,&H000000FF,&H00000000,&H00000000,0,0,0,0,100,100,0,0,1,2,0," +
+		"2,10,10,10,1\n\n")
+	bw.WriteString("[Events]\n")
+	bw.WriteString("Format: Layer, Start, End, Style, Name, MarginL, MarginR, MarginV, Effect, Text\n")
+
+	for _, line := range lines {
+		text, err := h.ASSKaraoke(line)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(bw, "Dialogue: 0,%s,%s,Default,,0,0,0,karaoke,%s\n", formatASSTime(line.Start),
+			formatASSTime(karaokeLineEnd(line)), text)
+	}
+
+	return bw.Flush()
+}
+
+// LRCKaraoke returns an enhanced LRC line for line: the line time, and the time of each syllable, followed by
+// the time the line ends.
+func (h *Hyphenator) LRCKaraoke(line KaraokeLine) (string, error) {
+	syllables, err := h.KaraokeSyllables(line)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	b.WriteString("[" + formatLRCTime(line.Start) + "]")
+	for _, syllable := range syllables {
+		b.WriteString("<" + formatLRCTime(syllable.Start) + ">" + strings.Join(strings.Fields(syllable.Text), " "))
+
+		// strings.Fields drops the whitespace that separates the words.
+		if r, _ := utf8.DecodeLastRuneInString(syllable.Text); r == ' ' || r == '\n' || r == '\t' {
+			b.WriteString(" ")
+		}
+	}
+	if len(syllables) > 0 {
+		last := syllables[len(syllables)-1]
+		b.WriteString("<" + formatLRCTime(last.Start+last.Duration) + ">")
+	}
+
+	return b.String(), nil
+}
+
+// WriteLRC writes an enhanced LRC file with a line for each line.
+func (h *Hyphenator) WriteLRC(w io.Writer, lines []KaraokeLine) error {
+	bw := bufio.NewWriter(w)
+
+	for _, line := range lines {
+		text, err := h.LRCKaraoke(line)
+		if err != nil {
+			return err
+		}
+
+		bw.WriteString(text + "\n")
+	}
+
+	return bw.Flush()
+}
+
+func karaokeLineEnd(line KaraokeLine) time.Duration {
+	if line.Durations == nil || line.End != 0 {
+		return line.End
+	}
+
+	end := line.Start
+	for _, d := range line.Durations {
+		end += d
+	}
+
+	return end
+}
+
+// formatASSTime formats d as H:MM:SS.cc.
+func formatASSTime(d time.Duration) string {
+	cs := d.Round(10*time.Millisecond).Milliseconds() / 10
+
+	return fmt.Sprintf("%d:%02d:%02d.%02d", cs/360000, cs/6000%60, cs/100%60, cs%100)
+}
+
+// formatLRCTime formats d as mm:ss.xx.
+func formatLRCTime(d time.Duration) string {
+	cs := d.Round(10*time.Millisecond).Milliseconds() / 10
+
+	return fmt.Sprintf("%02d:%02d.%02d", cs/6000, cs/100%60, cs%100)
+}
@@ -0,0 +1,114 @@
+package grhyph
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestKaraokeSyllables(t *testing.T) {
+	h, err := NewHyphenator(GetDefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Synizesis is sung as one syllable, and punctuation goes with the syllable before it.
+	line := KaraokeLine{Text: "«Δυο παιδιά», καλημέρα!", Start: time.Second, End: 3 * time.Second}
+	expected := []KaraokeSyllable{
+		{"«Δυο ", 1000 * time.Millisecond, 290 * time.Millisecond},
+		{"παι", 1290 * time.Millisecond, 280 * time.Millisecond},
+		{"διά», ", 1570 * time.Millisecond, 290 * time.Millisecond},
+		{"κα", 1860 * time.Millisecond, 280 * time.Millisecond},
+		{"λη", 2140 * time.Millisecond, 290 * time.Millisecond},
+		{"μέ", 2430 * time.Millisecond, 280 * time.Millisecond},
+		{"ρα!", 2710 * time.Millisecond, 290 * time.Millisecond},
+	}
+
+	syllables, err := h.KaraokeSyllables(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(syllables, expected) {
+		t.Errorf("(%s) Karaoke syllables do not match: expected %v, got %v", line.Text, expected, syllables)
+	}
+
+	line.Durations = []time.Duration{time.Second}
+	if _, err := h.KaraokeSyllables(line); !errors.Is(err, ErrSyllableCount) {
+		t.Errorf("(%s) Expected ErrSyllableCount, got %v", line.Text, err)
+	}
+}
+
+func TestASSKaraoke(t *testing.T) {
+	h, err := NewHyphenator(GetDefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		line     KaraokeLine
+		expected string
+	}{
+		{KaraokeLine{Text: "Καλημέρα", End: time.Second}, `{\k25}Κα{\k25}λη{\k25}μέ{\k25}ρα`},
+		{
+			KaraokeLine{Text: "Γεια σου", Start: time.Second, Durations: []time.Duration{500 * time.Millisecond,
+				150 * time.Millisecond}},
+			`{\k50}Γεια {\k15}σου`,
+		},
+		{KaraokeLine{Text: "{Ναι}\nόχι", End: 400 * time.Millisecond}, `{\k13}\{Ναι\}\N{\k14}ό{\k13}χι`},
+	}
+
+	for _, test := range tests {
+		text, err := h.ASSKaraoke(test.line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if text != test.expected {
+			t.Errorf("(%s) ASS karaoke does not match: expected %s, got %s", test.line.Text, test.expected, text)
+		}
+	}
+}
+
+func TestWriteLRC(t *testing.T) {
+	s, err := ParseSubtitles(strings.NewReader("1\n00:00:01,000 --> 00:00:02,000\n<i>Καλημέρα</i>\n\n" +
+		"2\n00:01:02,000 --> 00:01:03,500\nΓεια σου\nκόσμε\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h, err := NewHyphenator(GetDefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	if err := h.WriteLRC(&b, s.KaraokeLines()); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "[00:01.00]<00:01.00>Κα<00:01.25>λη<00:01.50>μέ<00:01.75>ρα<00:02.00>\n" +
+		"[01:02.00]<01:02.00>Γεια <01:02.38>σου <01:02.75>κό<01:03.13>σμε<01:03.50>\n"
+	if b.String() != expected {
+		t.Errorf("LRC karaoke does not match: expected %s, got %s", expected, b.String())
+	}
+}
+
+func TestWriteASS(t *testing.T) {
+	h, err := NewHyphenator(GetDefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	lines := []KaraokeLine{{Text: "Ναι", Start: time.Hour + 2*time.Second, Durations: []time.Duration{time.Second}}}
+	if err := h.WriteASS(&b, lines); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "Dialogue: 0,1:00:02.00,1:00:03.00,Default,,0,0,0,karaoke,{\\k100}Ναι\n"
+	if !strings.HasSuffix(b.String(), expected) {
+		t.Errorf("ASS karaoke does not match: expected a suffix of %s, got %s", expected, b.String())
+	}
+}
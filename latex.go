@@ -0,0 +1,138 @@
+package grhyph
+
+import (
+	"bufio"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// texEscapes are the replacements of the characters that are special to TeX.
+var texEscapes = map[rune]string{
+	'\\': `\textbackslash{}`,
+	'{':  `\{`,
+	'}':  `\}`,
+	'$':  `\$`,
+	'&':  `\&`,
+	'#':  `\#`,
+	'_':  `\_`,
+	'%':  `\%`,
+	'~':  `\textasciitilde{}`,
+	'^':  `\textasciicircum{}`,
+}
+
+// LaTeXHyphenate copies the text read from r to w as LaTeX, escaping the characters that are special to TeX
+// and inserting a \- discretionary hyphen at every break. Options.Separator is ignored.
+func LaTeXHyphenate(r io.Reader, w io.Writer, o Options) error {
+	h, err := NewHyphenator(o)
+	if err != nil {
+		return err
+	}
+
+	text, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, h.HyphenateLaTeX(string(text)))
+
+	return err
+}
+
+// HyphenateLaTeX returns s as LaTeX, as LaTeXHyphenate does.
+func (h *Hyphenator) HyphenateLaTeX(s string) string {
+	var b strings.Builder
+
+	for _, r := range h.markBreaks(s) {
+		if _, ok := breakClassOf(r); ok {
+			b.WriteString(`\-`)
+		} else if escaped, ok := texEscapes[r]; ok {
+			b.WriteString(escaped)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// WriteHyphenationExceptions writes a TeX \hyphenation{...} block to w, listing the words whose hyphenation
+// by the GrhyphRules differs from plain syllabification. The Options.UseGrhyphRules and Separator of o are
+// ignored.
+func WriteHyphenationExceptions(w io.Writer, words []string, o Options) error {
+	o.UseGrhyphRules = true
+
+	h, err := NewHyphenatorWithCache(o, NoCache{})
+	if err != nil {
+		return err
+	}
+
+	return h.WriteHyphenationExceptions(w, words)
+}
+
+// WriteHyphenationExceptions is like the package function, but uses the rules and options of h.
+func (h *Hyphenator) WriteHyphenationExceptions(w io.Writer, words []string) error {
+	bw := bufio.NewWriter(w)
+
+	bw.WriteString("\\hyphenation{\n")
+	for _, exception := range h.HyphenationExceptions(words) {
+		bw.WriteString("  " + exception + "\n")
+	}
+	bw.WriteString("}\n")
+
+	return bw.Flush()
+}
+
+// HyphenationExceptions returns, sorted and in lower case, the words whose hyphenation by the rules of h
+// differs from plain syllabification, hyphenated by the rules with "-". Both are applied to the accented
+// words with Options.RestoreAccents, as in HyphenateLaTeX. A word without any "-" is not to be hyphenated at
+// all. Words that TeX would not accept in \hyphenation, i.e. those with characters other than letters, are
+// skipped.
+func (h *Hyphenator) HyphenationExceptions(words []string) []string {
+	var (
+		exceptions []string
+		seen       = map[string]bool{}
+		hyphens    = breakMarksReplacer("-")
+	)
+
+	for _, word := range words {
+		word = strings.ToLower(word)
+		if seen[word] || !isTeXWord(word) {
+			continue
+		}
+		seen[word] = true
+
+		speechSounds, _ := stringTospeechSounds(word)
+
+		rules := h.markRestoredBreaks(word, speechSounds, func(s string, speechSounds []SpeechSound) string {
+			return h.hyphenMins(s, speechSounds, h.regexpHyphenation(s, speechSounds))
+		})
+		plain := h.markRestoredBreaks(word, speechSounds, func(s string, speechSounds []SpeechSound) string {
+			return h.hyphenMins(s, speechSounds, plainHyphenation(speechSounds, h.options, h.wSCRe))
+		})
+
+		rules, plain = hyphens.Replace(rules), hyphens.Replace(plain)
+		if rules != plain {
+			exceptions = append(exceptions, rules)
+		}
+	}
+
+	sort.Strings(exceptions)
+
+	return exceptions
+}
+
+func isTeXWord(word string) bool {
+	if word == "" {
+		return false
+	}
+
+	for _, r := range word {
+		if !unicode.IsLetter(r) && !unicode.Is(unicode.Mn, r) {
+			return false
+		}
+	}
+
+	return true
+}
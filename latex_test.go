@@ -0,0 +1,67 @@
+package grhyph
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestHyphenateLaTeX(t *testing.T) {
+	tests := []hyphenationTest{
+		{"Καλημέρα κόσμε", `Κα\-λη\-μέ\-ρα κό\-σμε`},
+		{"{καλημέρα} 50% & $x_1$", `\{κα\-λη\-μέ\-ρα\} 50\% \& \$x\_1\$`},
+		{`\ ~^#`, `\textbackslash{} \textasciitilde{}\textasciicircum{}\#`},
+	}
+
+	for _, test := range tests {
+		var b bytes.Buffer
+		if err := LaTeXHyphenate(strings.NewReader(test.input), &b, GetDefaultOptions()); err != nil {
+			t.Fatal(err)
+		}
+
+		if b.String() != test.hyphenated {
+			t.Errorf("(%s) Hyphenated value does not match: expected %s, got %s", test.input, test.hyphenated, b.String())
+		}
+	}
+}
+
+func TestWriteHyphenationExceptions(t *testing.T) {
+	words := []string{"Αηδόνια", "αηδόνια", "καλημέρα", "δυο", "ιστορία", "anthia", "ένα-δύο", "x2"}
+
+	expected := "\\hyphenation{\n  an-thia\n  αη-δό-νια\n  δυο\n}\n"
+
+	var b bytes.Buffer
+	if err := WriteHyphenationExceptions(&b, words, GetDefaultOptions()); err != nil {
+		t.Fatal(err)
+	}
+
+	if b.String() != expected {
+		t.Errorf("Exceptions do not match: expected %q, got %q", expected, b.String())
+	}
+}
+
+func TestHyphenationExceptionsRestoreAccents(t *testing.T) {
+	hyphenationOptions := GetDefaultOptions()
+	hyphenationOptions.UseGrhyphRules = true
+	hyphenationOptions.RestoreAccents = true
+
+	h, err := NewHyphenator(hyphenationOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	words := []string{"βια", "εκκλησια", "αηδονια", "καλημερα"}
+
+	exceptions := h.HyphenationExceptions(words)
+	if len(exceptions) == 0 {
+		t.Fatalf("(%v) Expected exceptions", words)
+	}
+
+	// Every exception must be hyphenated as HyphenateLaTeX hyphenates the word.
+	for _, exception := range exceptions {
+		word := strings.Replace(exception, "-", "", -1)
+		if hyphenated := strings.Replace(h.HyphenateLaTeX(word), `\-`, "-", -1); hyphenated != exception {
+			t.Errorf("(%s) Exception does not match HyphenateLaTeX: expected %s, got %s", word, hyphenated, exception)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package grhyph
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// LatinScheme is a romanization of Greek.
+type LatinScheme int
+
+const (
+	// ELOT743 is the transcription of ELOT 743, i.e. ISO 843 type 2, of Greek passports. Accents are dropped,
+	// αυ, ευ and ηυ are spelled as they sound, and γ is "n" before γ, ξ and χ. μπ is "b" at the start and
+	// the end of a word and "mp" elsewhere, while ντ is always "nt" and γκ is always "gk".
+	ELOT743 LatinScheme = iota
+
+	// ISO843 is the reversible transliteration of ISO 843 type 1. η is ī, ω is ō, and accents are kept.
+	ISO843
+
+	// CommonGreeklish is the informal spelling of Greek in Latin letters, that ToGreek reads back. Accents are
+	// dropped, ξ is "ks" and χ is "x".
+	CommonGreeklish
+)
+
+// LatinSyllable is a syllable of the Greek input of ToLatinSyllables, and its transliteration.
+type LatinSyllable struct {
+	Syllable
+
+	Latin string `json:"latin"`
+
+	// LatinStart and LatinEnd are byte offsets into the transliteration.
+	LatinStart int `json:"latin_start"`
+	LatinEnd   int `json:"latin_end"`
+}
+
+// latinLetters are the ELOT 743 spellings of the Greek letters, and the letters that the other schemes spell
+// differently.
+var (
+	latinLetters = map[rune]string{
+		'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th", 'ι': "i", 'κ': "k",
+		'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t",
+		'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+	}
+
+	iso843Letters = map[rune]string{'η': "ī", 'ω': "ō"}
+
+	commonGreeklishLetters = map[rune]string{'ξ': "ks", 'χ': "x"}
+)
+
+// Accented Greek vowels, by their unaccented letters.
+var greekAccents = map[rune]struct {
+	letter           rune
+	acute, diaeresis bool
+}{
+	'ά': {'α', true, false}, 'έ': {'ε', true, false}, 'ή': {'η', true, false}, 'ί': {'ι', true, false},
+	'ό': {'ο', true, false}, 'ύ': {'υ', true, false}, 'ώ': {'ω', true, false},
+	'ϊ': {'ι', false, true}, 'ϋ': {'υ', false, true}, 'ΐ': {'ι', true, true}, 'ΰ': {'υ', true, true},
+}
+
+// voicelessConsonants are the Greek consonants before which αυ, ευ and ηυ are spelled with an "f".
+const voicelessConsonants = "θκξπστφχψς"
+
+// ToLatin romanizes the Greek letters of text in scheme, leaving any other character as it is. The spelling
+// of the digraphs of SpeechSoundRe depends on their neighbours: in ELOT 743, αυ is "av" before vowels and
+// voiced consonants but "af" before voiceless ones and at the end of a word, and μπ is "b" at the start or the
+// end of a word but "mp" within it.
+func ToLatin(text string, scheme LatinScheme) string {
+	latin, _ := transliterate(text, scheme)
+
+	return latin
+}
+
+// ToLatinSyllables romanizes text as ToLatin does, and aligns the syllables of text, as Syllabify splits
+// them, with their transliterations.
+func (h *Hyphenator) ToLatinSyllables(text string, scheme LatinScheme) (string, []LatinSyllable) {
+	latin, offsets := transliterate(text, scheme)
+
+	var syllables []LatinSyllable
+	for _, syllable := range h.Syllabify(text) {
+		start, end := offsets[syllable.Start], offsets[syllable.End]
+
+		syllables = append(syllables, LatinSyllable{
+			Syllable:   syllable,
+			Latin:      latin[start:end],
+			LatinStart: start,
+			LatinEnd:   end,
+		})
+	}
+
+	return latin, syllables
+}
+
+// HyphenateToLatin romanizes text as ToLatin does, and inserts the separator at the break points of the
+// Greek text, so that the syllables of the output line up with those of Hyphenate.
+func (h *Hyphenator) HyphenateToLatin(text string, scheme LatinScheme) string {
+	latin, offsets := transliterate(text, scheme)
+
+	var (
+		b    strings.Builder
+		last int
+	)
+
+	for _, bp := range h.BreakPoints(text) {
+		offset := offsets[bp.Position]
+		b.WriteString(latin[last:offset] + h.options.Separator)
+		last = offset
+	}
+	b.WriteString(latin[last:])
+
+	return b.String()
+}
+
+// transliterate romanizes text, one word at a time, and returns the offsets into the transliteration of the
+// bytes of text that start a rune, as well as of len(text).
+func transliterate(text string, scheme LatinScheme) (string, []int) {
+	var (
+		b       strings.Builder
+		offsets = make([]int, len(text)+1)
+		start   = -1
+	)
+
+	writeWord := func(end int) {
+		word := text[start:end]
+		pieces := latinPieces(word, scheme)
+		for i := range word {
+			offsets[start+i] = b.Len()
+			b.WriteString(pieces[i])
+		}
+		start = -1
+	}
+
+	for i, r := range text {
+		if !isWordBoundary(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+
+		if start >= 0 {
+			writeWord(i)
+		}
+		offsets[i] = b.Len()
+		b.WriteRune(r)
+	}
+
+	if start >= 0 {
+		writeWord(len(text))
+	}
+	offsets[len(text)] = b.Len()
+
+	return b.String(), offsets
+}
+
+// latinPieces returns the romanization of each rune of word, by the byte offset of the rune. Digraphs that
+// are spelled with a single Latin letter, e.g. the μπ of "μπάλα", spell their second rune as "".
+func latinPieces(word string, scheme LatinScheme) map[int]string {
+	speechSounds, _ := stringTospeechSounds(word)
+
+	upper := utf8.RuneCountInString(word) > 1 && strings.IndexFunc(word, unicode.IsLower) < 0
+
+	pieces := map[int]string{}
+	offset := 0
+
+	for i, speechSound := range speechSounds {
+		var (
+			runes    = []rune(speechSound.Match)
+			letters  = make([]rune, len(runes))
+			acutes   = make([]bool, len(runes))
+			spelling = make([]string, len(runes))
+		)
+
+		for j, r := range runes {
+			letters[j] = unicode.ToLower(r)
+			if accent, ok := greekAccents[letters[j]]; ok {
+				letters[j] = accent.letter
+				acutes[j] = accent.acute && scheme == ISO843
+				spelling[j] = latinLetter(letters[j], scheme) + diacritics(accent.acute, accent.diaeresis, scheme)
+			} else {
+				spelling[j] = latinLetter(letters[j], scheme)
+			}
+		}
+
+		var next rune
+		if i+1 < len(speechSounds) {
+			next, _ = utf8.DecodeRuneInString(speechSounds[i+1].Match)
+			next = unicode.ToLower(next)
+		}
+		wordStart := i == 0
+		wordEnd := !speechSound.ImmediateVowelExists && speechSound.ImmediateConsonants == 0
+
+		switch string(letters) {
+		case "αυ", "ευ", "ηυ":
+			switch {
+			case scheme == ISO843:
+				spelling[1] = "u" + diacritics(acutes[1], false, scheme)
+			case speechSound.ImmediateVowelExists:
+				spelling[1] = "v"
+			case speechSound.ImmediateConsonants > 0 && !strings.ContainsRune(voicelessConsonants, next):
+				spelling[1] = "v"
+			default:
+				spelling[1] = "f"
+			}
+
+		case "ου":
+			spelling[1] = "u" + diacritics(acutes[1], false, scheme)
+
+		case "μπ":
+			if wordStart && scheme != ISO843 || wordEnd && scheme == ELOT743 {
+				spelling[0], spelling[1] = "b", ""
+			}
+
+		case "γκ":
+			if wordStart && scheme == CommonGreeklish {
+				spelling[0], spelling[1] = "g", ""
+			}
+
+		case "γ":
+			if scheme == ELOT743 && (next == 'γ' || next == 'ξ' || next == 'χ') {
+				spelling[0] = "n"
+			}
+		}
+
+		for j, r := range runes {
+			piece := spelling[j]
+			switch {
+			case piece == "" && latinLetter(letters[j], scheme) == "":
+				piece = string(r)
+			case !unicode.IsUpper(r) || piece == "":
+			case upper:
+				piece = strings.ToUpper(piece)
+			default:
+				first, size := utf8.DecodeRuneInString(piece)
+				piece = string(unicode.ToUpper(first)) + piece[size:]
+			}
+
+			pieces[offset] = norm.NFC.String(piece)
+			offset += utf8.RuneLen(r)
+		}
+	}
+
+	return pieces
+}
+
+// latinLetter returns the spelling of a lowercase, unaccented Greek letter in scheme, or "" for any other rune.
+func latinLetter(r rune, scheme LatinScheme) string {
+	switch scheme {
+	case ISO843:
+		if latin, ok := iso843Letters[r]; ok {
+			return latin
+		}
+	case CommonGreeklish:
+		if latin, ok := commonGreeklishLetters[r]; ok {
+			return latin
+		}
+	}
+
+	return latinLetters[r]
+}
+
+// diacritics returns the combining accents that ISO 843 keeps, to be composed with the letter before them.
+func diacritics(acute, diaeresis bool, scheme LatinScheme) string {
+	if scheme != ISO843 {
+		return ""
+	}
+
+	var marks string
+	if diaeresis {
+		marks += "\u0308"
+	}
+	if acute {
+		marks += "\u0301"
+	}
+
+	return marks
+}
@@ -0,0 +1,82 @@
+package grhyph
+
+import (
+	"testing"
+)
+
+func TestToLatin(t *testing.T) {
+	tests := []struct {
+		input                string
+		elot, iso, greeklish string
+	}{
+		{"Καλημέρα σας", "Kalimera sas", "Kalīméra sas", "Kalimera sas"},
+		{"ΑΥΤΟΚΙΝΗΤΟ", "AFTOKINITO", "AUTOKINĪTO", "AFTOKINITO"},
+		{"Ευάγγελος, ευχαριστώ", "Evangelos, efcharisto", "Euággelos, eucharistṓ", "Evaggelos, efxaristo"},
+		{"μπάλα, Λαμπράκης, κλαμπ", "bala, Lamprakis, klab", "mpála, Lamprákīs, klamp", "bala, Lamprakis, klamp"},
+		{"ντομάτα, πέντε, άγκυρα, σφίγξ", "ntomata, pente, agkyra, sfinx", "ntomáta, pénte, ágkyra, sfígx",
+			"ntomata, pente, agkyra, sfigks"},
+		{"μπόρα, κάμπος, σνομπ", "bora, kampos, snob", "mpóra, kámpos, snomp", "bora, kampos, snomp"},
+		{"ντύνω, άντρας, Ρεμπράντ", "ntyno, antras, Remprant", "ntýnō, ántras, Rempránt", "ntyno, antras, Remprant"},
+		{"γκρίζος, αγκαλιά, ζιγκ", "gkrizos, agkalia, zigk", "gkrízos, agkaliá, zigk", "grizos, agkalia, zigk"},
+		{"Ψυχή, Χαΐδω, ηύρα", "Psychi, Chaido, ivra", "Psychī́, Chaḯdō, īúra", "Psyxi, Xaido, ivra"},
+		{"abc 123 «Ωραία»", "abc 123 «Oraia»", "abc 123 «Ōraía»", "abc 123 «Oraia»"},
+	}
+
+	for _, test := range tests {
+		for scheme, expected := range map[LatinScheme]string{ELOT743: test.elot, ISO843: test.iso,
+			CommonGreeklish: test.greeklish} {
+			if latin := ToLatin(test.input, scheme); latin != expected {
+				t.Errorf("(%s) Latin value does not match: expected %s, got %s", test.input, expected, latin)
+			}
+		}
+	}
+}
+
+func TestHyphenateToLatin(t *testing.T) {
+	tests := []hyphenationTest{
+		{"Καλημέρα σας", "Ka-li-me-ra sas"},
+		{"Ευάγγελος Μπάμπης", "Ev-an-ge-los Ba-mpis"},
+		{"Θεσσαλονίκη", "Thes-sa-lo-ni-ki"},
+	}
+
+	hyphenationOptions := GetDefaultOptions()
+	hyphenationOptions.Separator = "-"
+
+	h, err := NewHyphenator(hyphenationOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range tests {
+		if hyphenated := h.HyphenateToLatin(test.input, ELOT743); hyphenated != test.hyphenated {
+			t.Errorf("(%s) Hyphenated value does not match: expected %s, got %s", test.input, test.hyphenated,
+				hyphenated)
+		}
+	}
+}
+
+func TestToLatinSyllables(t *testing.T) {
+	h, err := NewHyphenator(GetDefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	latin, syllables := h.ToLatinSyllables("Ευάγγελος Παπαδόπουλος", ELOT743)
+	if latin != "Evangelos Papadopoulos" {
+		t.Errorf("Latin value does not match: expected Evangelos Papadopoulos, got %s", latin)
+	}
+
+	expected := []string{"Ευ=Ev", "άγ=an", "γε=ge", "λος=los", "Πα=Pa", "πα=pa", "δό=do", "που=pou", "λος=los"}
+	if len(syllables) != len(expected) {
+		t.Fatalf("Syllable count does not match: expected %d, got %d", len(expected), len(syllables))
+	}
+
+	for i, syllable := range syllables {
+		if got := syllable.Text + "=" + syllable.Latin; got != expected[i] {
+			t.Errorf("(%s) Syllable alignment does not match: expected %s, got %s", syllable.Text, expected[i], got)
+		}
+		if latin[syllable.LatinStart:syllable.LatinEnd] != syllable.Latin {
+			t.Errorf("(%s) Latin offsets do not match %s", syllable.Text, syllable.Latin)
+		}
+	}
+}
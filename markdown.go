@@ -0,0 +1,139 @@
+package grhyph
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// MarkdownHyphenate copies the Markdown document read from r to w, inserting the separator into its prose
+// only. Front matter, code, headings, link destinations, images, autolinks, raw HTML, character references
+// and URLs are written exactly as read. Headings are left alone so that their generated IDs do not change.
+func MarkdownHyphenate(r io.Reader, w io.Writer, o Options) error {
+	h, err := NewHyphenator(o)
+	if err != nil {
+		return err
+	}
+
+	source, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(h.HyphenateMarkdown(source))
+
+	return err
+}
+
+// HyphenateMarkdown returns the Markdown source with its prose hyphenated, as MarkdownHyphenate does. Words
+// split by inline markup, e.g. "**Καλη**μέρα", are hyphenated as a whole, as HTMLHyphenate does.
+func (h *Hyphenator) HyphenateMarkdown(source []byte) []byte {
+	var b bytes.Buffer
+	bw := bufio.NewWriter(&b)
+
+	frontMatterEnd := markdownFrontMatterEnd(source)
+	bw.Write(source[:frontMatterEnd])
+
+	var run htmlRun
+
+	last := frontMatterEnd
+	for _, segment := range markdownProse(source, frontMatterEnd) {
+		if segment.joined {
+			run.raw = append(run.raw, source[last:segment.Start]...)
+		} else {
+			run.addBoundary(source[last:segment.Start])
+		}
+		run.addProse(segment.Value(source))
+		last = segment.Stop
+	}
+
+	run.addBoundary(source[last:])
+	run.flush(bw, h)
+	bw.Flush()
+
+	return b.Bytes()
+}
+
+// markdownSegment is a segment of Markdown source that holds prose.
+type markdownSegment struct {
+	text.Segment
+
+	// joined is set if only emphasis or link markup separates the segment from the one before, so that a word
+	// may continue across them, e.g. "**Καλη**μέρα".
+	joined bool
+}
+
+// markdownProse returns the segments of source, after offset, that hold prose, in increasing order.
+// Adjacent text nodes are joined, so that no word is split between segments.
+func markdownProse(source []byte, offset int) []markdownSegment {
+	document := goldmark.DefaultParser().Parse(text.NewReader(source[offset:]))
+
+	var (
+		segments []markdownSegment
+		joinNext bool
+	)
+
+	ast.Walk(document, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch n.Kind() {
+		case ast.KindHeading, ast.KindCodeSpan, ast.KindImage, ast.KindAutoLink, ast.KindRawHTML,
+			ast.KindCodeBlock, ast.KindFencedCodeBlock, ast.KindHTMLBlock:
+			joinNext = false
+			return ast.WalkSkipChildren, nil
+
+		case ast.KindText:
+			t := n.(*ast.Text)
+			segment := text.NewSegment(t.Segment.Start+offset, t.Segment.Stop+offset)
+
+			if len(segments) > 0 && segments[len(segments)-1].Stop == segment.Start {
+				segments[len(segments)-1].Stop = segment.Stop
+			} else if segment.Len() > 0 {
+				segments = append(segments, markdownSegment{segment, joinNext && len(segments) > 0})
+			}
+
+			joinNext = !t.SoftLineBreak() && !t.HardLineBreak()
+
+		default:
+			if n.Type() == ast.TypeBlock {
+				joinNext = false
+			}
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return segments
+}
+
+// markdownFrontMatterEnd returns the length of the YAML ("---") or TOML ("+++") front matter that source
+// starts with, or 0 if there is none.
+func markdownFrontMatterEnd(source []byte) int {
+	for _, delimiter := range []string{"---", "+++"} {
+		line, rest, found := bytes.Cut(source, []byte("\n"))
+		if !found || string(bytes.TrimRight(line, " \r")) != delimiter {
+			continue
+		}
+
+		end := len(line) + 1
+		for len(rest) > 0 {
+			line, rest, found = bytes.Cut(rest, []byte("\n"))
+			end += len(line)
+			if found {
+				end++
+			}
+
+			if string(bytes.TrimRight(line, " \r")) == delimiter {
+				return end
+			}
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,38 @@
+package grhyph
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestMarkdownHyphenate(t *testing.T) {
+	tests := []hyphenationTest{
+		{"καλημέρα κόσμε", "κα-λη-μέ-ρα κό-σμε"},
+		{"---\ntitle: καλημέρα\n---\nκαλημέρα\n", "---\ntitle: καλημέρα\n---\nκα-λη-μέ-ρα\n"},
+		{"+++\ntitle = \"καλημέρα\"\n+++\n\nκαλημέρα", "+++\ntitle = \"καλημέρα\"\n+++\n\nκα-λη-μέ-ρα"},
+		{"# Καλημέρα\n\nΚαλημέρα\n===\n\nκαλημέρα", "# Καλημέρα\n\nΚαλημέρα\n===\n\nκα-λη-μέ-ρα"},
+		{"καλημέρα `καλημέρα` *καλημέρα* __καλη__μέρα", "κα-λη-μέ-ρα `καλημέρα` *κα-λη-μέ-ρα* __κα-λη__μέ-ρα"},
+		{"**Θεσ**σαλονίκη Θεσ*σα*λονίκη [Θεσ](/)σαλονίκη", "**Θεσ-**σα-λο-νί-κη Θεσ-*σα-*λο-νί-κη [Θεσ-](/)σα-λο-νί-κη"},
+		{"Θεσ`σα`λονίκη Θεσ\nσαλονίκη", "Θεσ`σα`λο-νί-κη Θεσ\nσα-λο-νί-κη"},
+		{"[καλημέρα](https://example.gr/καλημέρα \"καλημέρα\") ![καλημέρα](καλημέρα.png)", "[κα-λη-μέ-ρα](https://example.gr/καλημέρα \"καλημέρα\") ![καλημέρα](καλημέρα.png)"},
+		{"[καλημέρα][καλη]\n\n[καλη]: /καλημέρα", "[κα-λη-μέ-ρα][καλη]\n\n[καλη]: /καλημέρα"},
+		{"<https://example.gr/καλημέρα> www.example.gr/καλημέρα <b title=\"καλημέρα\">καλημέρα</b>", "<https://example.gr/καλημέρα> www.example.gr/καλημέρα <b title=\"καλημέρα\">κα-λη-μέ-ρα</b>"},
+		{"    καλημέρα\n\n```\nκαλημέρα\n```\n\n<div>\nκαλημέρα\n</div>\n", "    καλημέρα\n\n```\nκαλημέρα\n```\n\n<div>\nκαλημέρα\n</div>\n"},
+		{"> - καλημέρα &epsilon;\n>   καλημέρα\\\n>   καλημέρα  \n", "> - κα-λη-μέ-ρα &epsilon;\n>   κα-λη-μέ-ρα\\\n>   κα-λη-μέ-ρα  \n"},
+	}
+
+	hyphenationOptions := GetDefaultOptions()
+	hyphenationOptions.Separator = "-"
+
+	for _, test := range tests {
+		var b bytes.Buffer
+		if err := MarkdownHyphenate(strings.NewReader(test.input), &b, hyphenationOptions); err != nil {
+			t.Fatal(err)
+		}
+
+		if b.String() != test.hyphenated {
+			t.Errorf("(%s) Hyphenated value does not match: expected %s, got %s", test.input, test.hyphenated, b.String())
+		}
+	}
+}
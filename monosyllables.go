@@ -0,0 +1,91 @@
+package grhyph
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// MonosyllableOptions configure CheckMonosyllables.
+type MonosyllableOptions struct {
+	// Exceptions are the accented monosyllables that are allowed, in lowercase. Nil stands for
+	// DefaultMonosyllableExceptions, and an empty slice allows none.
+	Exceptions []string
+}
+
+// DefaultMonosyllableExceptions are the accented monosyllables of the monotonic system: the disjunctive ή, and
+// the interrogative πού and πώς.
+var DefaultMonosyllableExceptions = []string{"ή", "πού", "πώς"}
+
+// MonosyllableError is an accented monosyllable that is not an exception.
+type MonosyllableError struct {
+	Word string `json:"word"`
+
+	// Start and End are the byte offsets of Word into the checked text.
+	Start int `json:"start"`
+	End   int `json:"end"`
+
+	// Suggestion is the word without its accent, but with its diaeresis.
+	Suggestion string `json:"suggestion"`
+}
+
+// CheckMonosyllables reports the accented monosyllables of text, other than the exceptions of o. Syllables
+// are counted as Stress counts them: vowels prone to synizesis, as SynizesisVowelsRe tells, make a single
+// syllable unless the first of them is accented or GrhyphRules split them, so that "μιά", "γιά" and "πιό" are
+// reported, but "μία", "δύο" and "σκιά" are not. Words elided by an apostrophe, e.g. "είν'", keep their accent.
+func CheckMonosyllables(text string, o MonosyllableOptions) []MonosyllableError {
+	exceptions := o.Exceptions
+	if exceptions == nil {
+		exceptions = DefaultMonosyllableExceptions
+	}
+
+	var errors []MonosyllableError
+
+	for _, a := range StressText(text) {
+		if a.Syllables != 1 || a.Stressed != 0 {
+			continue
+		}
+
+		if r, _ := utf8.DecodeRuneInString(text[a.End:]); r == '\'' || r == '’' {
+			continue
+		}
+
+		word := strings.ToLower(a.Word)
+		if containsString(exceptions, word) {
+			continue
+		}
+
+		errors = append(errors, MonosyllableError{
+			Word:       a.Word,
+			Start:      a.Start,
+			End:        a.End,
+			Suggestion: matchAccentedCase(strings.Map(dropAcute, word), a.Word),
+		})
+	}
+
+	return errors
+}
+
+// dropAcute drops the accent of a lowercase Greek vowel, but not its diaeresis.
+func dropAcute(r rune) rune {
+	accent, ok := greekAccents[r]
+	switch {
+	case !ok || !accent.acute:
+		return r
+	case accent.diaeresis && accent.letter == 'ι':
+		return 'ϊ'
+	case accent.diaeresis:
+		return 'ϋ'
+	}
+
+	return accent.letter
+}
+
+func containsString(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+
+	return false
+}
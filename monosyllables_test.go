@@ -0,0 +1,45 @@
+package grhyph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckMonosyllables(t *testing.T) {
+	tests := []struct {
+		input    string
+		options  MonosyllableOptions
+		expected []MonosyllableError
+	}{
+		{"μια φορά", MonosyllableOptions{}, nil},
+		{"μία φορά, δύο φορές", MonosyllableOptions{}, nil},
+		{"πού είσαι; ή εδώ ή εκεί", MonosyllableOptions{}, nil},
+		{"είν' ωραίο", MonosyllableOptions{}, nil},
+		{"σκιά, σκιάς, σκιές, σκιών, τριών, χιών, διά", MonosyllableOptions{}, nil},
+		{"ΣΚΙΆ", MonosyllableOptions{}, nil},
+		{"μιά φορά", MonosyllableOptions{}, []MonosyllableError{{"μιά", 0, 6, "μια"}}},
+		{"Γειά σου, πιό πολύ", MonosyllableOptions{}, []MonosyllableError{
+			{"Γειά", 0, 8, "Γεια"},
+			{"πιό", 17, 23, "πιο"},
+		}},
+		{"ΜΙΆ", MonosyllableOptions{}, []MonosyllableError{{"ΜΙΆ", 0, 6, "ΜΙΑ"}}},
+		{"πόυ", MonosyllableOptions{}, nil},
+		{"πού", MonosyllableOptions{Exceptions: []string{}}, []MonosyllableError{{"πού", 0, 6, "που"}}},
+		{"μού είπε", MonosyllableOptions{Exceptions: []string{"μού"}}, nil},
+	}
+
+	for _, test := range tests {
+		errors := CheckMonosyllables(test.input, test.options)
+		if !reflect.DeepEqual(errors, test.expected) {
+			t.Errorf("(%s) monosyllable errors do not match: expected %v, got %v", test.input, test.expected, errors)
+		}
+	}
+}
+
+func TestDropAcute(t *testing.T) {
+	for r, expected := range map[rune]rune{'ά': 'α', 'ΐ': 'ϊ', 'ΰ': 'ϋ', 'ϊ': 'ϊ', 'κ': 'κ'} {
+		if got := dropAcute(r); got != expected {
+			t.Errorf("(%c) unaccented vowel does not match: expected %c, got %c", r, expected, got)
+		}
+	}
+}
@@ -0,0 +1,354 @@
+package grhyph
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var ErrUnsupportedDocument = errors.New("unsupported document")
+
+const softHyphen = "\u00AD"
+
+const (
+	wordprocessingNS = "http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+	odfTextNS        = "urn:oasis:names:tc:opendocument:xmlns:text:1.0"
+	odfOfficeNS      = "urn:oasis:names:tc:opendocument:xmlns:office:1.0"
+)
+
+// officeDialect tells where the text of a document format lies.
+type officeDialect struct {
+	parts      *regexp.Regexp    // The archive entries to hyphenate.
+	paragraphs map[xml.Name]bool // The elements that hold a paragraph; they may nest, e.g. in footnotes.
+	text       map[xml.Name]bool // The elements whose character data is text.
+	boundaries map[xml.Name]bool // The elements that separate words, e.g. tabs.
+	skip       map[xml.Name]bool // The elements whose content is left alone, e.g. tracked deletions.
+}
+
+func xmlNames(space string, locals ...string) map[xml.Name]bool {
+	names := map[xml.Name]bool{}
+	for _, local := range locals {
+		names[xml.Name{Space: space, Local: local}] = true
+	}
+
+	return names
+}
+
+var (
+	docxDialect = officeDialect{
+		parts:      regexp.MustCompile(`^word/(document|header\d*|footer\d*|footnotes|endnotes)\.xml$`),
+		paragraphs: xmlNames(wordprocessingNS, "p"),
+		text:       xmlNames(wordprocessingNS, "t"),
+		boundaries: xmlNames(wordprocessingNS, "tab", "ptab", "br", "cr", "sym", "noBreakHyphen", "softHyphen"),
+		skip:       xmlNames(wordprocessingNS, "del", "moveFrom"),
+	}
+
+	odtDialect = officeDialect{
+		parts:      regexp.MustCompile(`^(content|styles)\.xml$`),
+		paragraphs: xmlNames(odfTextNS, "p", "h"),
+		text:       xmlNames(odfTextNS, "p", "h", "span", "a", "meta", "ruby-base"),
+		boundaries: xmlNames(odfTextNS, "s", "tab", "line-break"),
+		skip:       xmlNames(odfTextNS, "tracked-changes", "note-citation", "ruby-text"),
+	}
+)
+
+func init() {
+	odtDialect.skip[xml.Name{Space: odfOfficeNS, Local: "annotation"}] = true
+}
+
+// OfficeHyphenate copies the DOCX or ODT document read from r, of size bytes, to w, inserting soft hyphens
+// into the text of its body, headers, footers and notes. Words split across formatting runs are hyphenated as
+// a whole, and each soft hyphen is written into the run of the letter before it. Deleted and moved text of
+// tracked changes, comments and the rest of the archive are copied as they are. Options.Separator is ignored.
+func OfficeHyphenate(r io.ReaderAt, size int64, w io.Writer, o Options) error {
+	h, err := NewHyphenator(o)
+	if err != nil {
+		return err
+	}
+
+	return h.HyphenateOffice(r, size, w)
+}
+
+// HyphenateOffice copies the DOCX or ODT document read from r, of size bytes, to w, as OfficeHyphenate does.
+func (h *Hyphenator) HyphenateOffice(r io.ReaderAt, size int64, w io.Writer) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnsupportedDocument, err)
+	}
+
+	dialect, err := officeDocumentDialect(zr)
+	if err != nil {
+		return err
+	}
+
+	zw := zip.NewWriter(w)
+
+	for _, f := range zr.File {
+		if !dialect.parts.MatchString(f.Name) {
+			if err := zw.Copy(f); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := hyphenateOfficePart(zw, f, h, dialect); err != nil {
+			return fmt.Errorf("%s: %w", f.Name, err)
+		}
+	}
+
+	return zw.Close()
+}
+
+// OfficeHyphenateFile hyphenates the DOCX or ODT file at name in place, as OfficeHyphenate does.
+func OfficeHyphenateFile(name string, o Options) error {
+	h, err := NewHyphenator(o)
+	if err != nil {
+		return err
+	}
+
+	return h.HyphenateOfficeFile(name)
+}
+
+// HyphenateOfficeFile hyphenates the DOCX or ODT file at name in place, as OfficeHyphenateFile does.
+func (h *Hyphenator) HyphenateOfficeFile(name string) error {
+	return rewriteFile(name, h.HyphenateOffice)
+}
+
+func officeDocumentDialect(zr *zip.Reader) (officeDialect, error) {
+	for _, f := range zr.File {
+		switch f.Name {
+		case "word/document.xml":
+			return docxDialect, nil
+
+		case "mimetype":
+			rc, err := f.Open()
+			if err != nil {
+				return officeDialect{}, err
+			}
+			mimetype, err := io.ReadAll(rc)
+			rc.Close()
+			if err != nil {
+				return officeDialect{}, err
+			}
+
+			if strings.HasPrefix(string(mimetype), "application/vnd.oasis.opendocument.text") {
+				return odtDialect, nil
+			}
+		}
+	}
+
+	return officeDialect{}, fmt.Errorf("%w: neither DOCX nor ODT", ErrUnsupportedDocument)
+}
+
+func hyphenateOfficePart(zw *zip.Writer, f *zip.File, h *Hyphenator, dialect officeDialect) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	doc, err := io.ReadAll(rc)
+	rc.Close()
+	if err != nil {
+		return err
+	}
+
+	hyphenated, err := hyphenateOfficeXML(doc, h, dialect)
+	if err != nil {
+		return err
+	}
+
+	w, err := zw.CreateHeader(&zip.FileHeader{
+		Name:          f.Name,
+		Comment:       f.Comment,
+		Method:        f.Method,
+		Modified:      f.Modified,
+		ExternalAttrs: f.ExternalAttrs,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(hyphenated)
+
+	return err
+}
+
+// officeParagraph is the text of a paragraph, gathered from its runs, with the offsets of its bytes into
+// the XML document; the word boundaries that stand for elements have no offsets, i.e. -1.
+type officeParagraph struct {
+	text   []byte
+	starts []int // The offset of the XML that each byte of text was decoded from.
+	ends   []int // The offset right after that XML.
+}
+
+func (p *officeParagraph) addBoundary() {
+	p.text = append(p.text, ' ')
+	p.starts = append(p.starts, -1)
+	p.ends = append(p.ends, -1)
+}
+
+// addText decodes raw character data, found at offset of the XML document.
+func (p *officeParagraph) addText(raw []byte, offset int) {
+	if bytes.HasPrefix(raw, []byte("<![CDATA[")) {
+		for i, b := range raw[len("<![CDATA[") : len(raw)-len("]]>")] {
+			p.text = append(p.text, b)
+			p.starts = append(p.starts, offset+len("<![CDATA[")+i)
+			p.ends = append(p.ends, offset+len("<![CDATA[")+i+1)
+		}
+		return
+	}
+
+	for i := 0; i < len(raw); {
+		decoded, size := decodeXMLChar(raw[i:])
+		for _, b := range []byte(decoded) {
+			p.text = append(p.text, b)
+			p.starts = append(p.starts, offset+i)
+			p.ends = append(p.ends, offset+i+size)
+		}
+		i += size
+	}
+}
+
+// decodeXMLChar decodes the character reference, or the line ending, that raw starts with. Other bytes are
+// returned as they are, one at a time.
+func decodeXMLChar(raw []byte) (string, int) {
+	switch raw[0] {
+	case '\r':
+		if len(raw) > 1 && raw[1] == '\n' {
+			return "\n", 2
+		}
+		return "\n", 1
+
+	case '&':
+		end := bytes.IndexByte(raw, ';')
+		if end < 0 {
+			break
+		}
+
+		switch name := string(raw[1:end]); {
+		case name == "amp":
+			return "&", end + 1
+		case name == "lt":
+			return "<", end + 1
+		case name == "gt":
+			return ">", end + 1
+		case name == "quot":
+			return "\"", end + 1
+		case name == "apos":
+			return "'", end + 1
+		case strings.HasPrefix(name, "#x"):
+			if r, err := strconv.ParseUint(name[2:], 16, 32); err == nil {
+				return string(rune(r)), end + 1
+			}
+		case strings.HasPrefix(name, "#"):
+			if r, err := strconv.ParseUint(name[1:], 10, 32); err == nil {
+				return string(rune(r)), end + 1
+			}
+		}
+	}
+
+	return string(raw[:1]), 1
+}
+
+// insertions returns the offsets of the XML document at which soft hyphens are to be inserted: right after
+// the letter before each break, so that the hyphen takes its formatting, or else right before the letter
+// after it.
+func (p *officeParagraph) insertions(h *Hyphenator) []int {
+	var offsets []int
+
+	for _, b := range h.BreakPoints(string(p.text)) {
+		i := b.Position
+
+		// Words that already have soft hyphens are hyphenated around them; do not double them.
+		if bytes.HasSuffix(p.text[:i], []byte(softHyphen)) || bytes.HasPrefix(p.text[i:], []byte(softHyphen)) {
+			continue
+		}
+
+		switch {
+		case i > 0 && p.ends[i-1] >= 0:
+			offsets = append(offsets, p.ends[i-1])
+		case i < len(p.text) && p.starts[i] >= 0:
+			offsets = append(offsets, p.starts[i])
+		}
+	}
+
+	return offsets
+}
+
+// hyphenateOfficeXML inserts soft hyphens into the text of an XML document of dialect, leaving every other
+// byte as it is.
+func hyphenateOfficeXML(doc []byte, h *Hyphenator, dialect officeDialect) ([]byte, error) {
+	var (
+		decoder    = xml.NewDecoder(bytes.NewReader(doc))
+		stack      []xml.Name
+		skipDepth  int
+		paragraphs []*officeParagraph
+		insertions []int
+	)
+
+	for {
+		offset := int(decoder.InputOffset())
+
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			stack = append(stack, t.Name)
+
+			switch {
+			case skipDepth > 0 || dialect.skip[t.Name]:
+				skipDepth++
+			case dialect.paragraphs[t.Name]:
+				// A paragraph within a paragraph, e.g. a footnote, separates the words around it.
+				if len(paragraphs) > 0 {
+					paragraphs[len(paragraphs)-1].addBoundary()
+				}
+				paragraphs = append(paragraphs, &officeParagraph{})
+			case dialect.boundaries[t.Name] && len(paragraphs) > 0:
+				paragraphs[len(paragraphs)-1].addBoundary()
+			}
+
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+
+			switch {
+			case skipDepth > 0:
+				skipDepth--
+			case dialect.paragraphs[t.Name] && len(paragraphs) > 0:
+				insertions = append(insertions, paragraphs[len(paragraphs)-1].insertions(h)...)
+				paragraphs = paragraphs[:len(paragraphs)-1]
+			}
+
+		case xml.CharData:
+			if skipDepth > 0 || len(paragraphs) == 0 || len(stack) == 0 || !dialect.text[stack[len(stack)-1]] {
+				continue
+			}
+
+			paragraphs[len(paragraphs)-1].addText(doc[offset:decoder.InputOffset()], offset)
+		}
+	}
+
+	sort.Ints(insertions)
+
+	hyphenated := make([]byte, 0, len(doc)+len(insertions)*len(softHyphen))
+	last := 0
+	for _, offset := range insertions {
+		hyphenated = append(hyphenated, doc[last:offset]...)
+		hyphenated = append(hyphenated, softHyphen...)
+		last = offset
+	}
+
+	return append(hyphenated, doc[last:]...), nil
+}
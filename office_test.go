@@ -0,0 +1,152 @@
+package grhyph
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// The contents of officeTestFile mark soft hyphens with "|".
+type officeTestFile struct {
+	name       string
+	content    string
+	hyphenated string // Empty when the content is copied as it is.
+}
+
+func writeOfficeTestDocument(t *testing.T, files []officeTestFile) string {
+	var b bytes.Buffer
+	zw := zip.NewWriter(&b)
+	for _, f := range files {
+		method := zip.Deflate
+		if f.name == "mimetype" {
+			method = zip.Store
+		}
+
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: f.name, Method: method})
+		if err != nil {
+			t.Fatal(err)
+		}
+		io.WriteString(w, strings.ReplaceAll(f.content, "|", softHyphen))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	name := filepath.Join(t.TempDir(), "document")
+	if err := os.WriteFile(name, b.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	return name
+}
+
+func checkOfficeTestDocument(t *testing.T, name string, files []officeTestFile) {
+	zr, err := zip.OpenReader(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer zr.Close()
+
+	if len(zr.File) != len(files) {
+		t.Fatalf("Entry count does not match: expected %d, got %d", len(files), len(zr.File))
+	}
+
+	for i, f := range zr.File {
+		expected := files[i].hyphenated
+		if expected == "" {
+			expected = files[i].content
+		}
+		expected = strings.ReplaceAll(expected, "|", softHyphen)
+
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if f.Name != files[i].name || string(content) != expected {
+			t.Errorf("(%s) Content does not match: expected %s, got %s", files[i].name, expected, content)
+		}
+	}
+}
+
+const docxDocumentXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>
+<w:p><w:r><w:rPr><w:b/></w:rPr><w:t>Καλη</w:t></w:r><w:r><w:t xml:space="preserve">μέρα &amp; </w:t></w:r><w:del w:id="1"><w:r><w:delText>καλημέρα</w:delText></w:r></w:del><w:ins w:id="2"><w:r><w:t>κόσμε</w:t></w:r></w:ins><w:r><w:tab/><w:t>&#x3b1;λλά</w:t></w:r></w:p>
+<w:p><w:r><w:t>κα</w:t></w:r><w:r><w:t>|λημέρα</w:t></w:r><w:r><w:instrText>PAGE καλημέρα</w:instrText></w:r></w:p>
+</w:body></w:document>`
+
+const docxDocumentXMLHyphenated = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>
+<w:p><w:r><w:rPr><w:b/></w:rPr><w:t>Κα|λη|</w:t></w:r><w:r><w:t xml:space="preserve">μέ|ρα &amp; </w:t></w:r><w:del w:id="1"><w:r><w:delText>καλημέρα</w:delText></w:r></w:del><w:ins w:id="2"><w:r><w:t>κό|σμε</w:t></w:r></w:ins><w:r><w:tab/><w:t>&#x3b1;λ|λά</w:t></w:r></w:p>
+<w:p><w:r><w:t>κα</w:t></w:r><w:r><w:t>|λη|μέ|ρα</w:t></w:r><w:r><w:instrText>PAGE καλημέρα</w:instrText></w:r></w:p>
+</w:body></w:document>`
+
+func TestOfficeHyphenateDOCX(t *testing.T) {
+	files := []officeTestFile{
+		{"[Content_Types].xml", `<Types/>`, ""},
+		{"word/document.xml", docxDocumentXML, docxDocumentXMLHyphenated},
+		{"word/styles.xml", `<w:t xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">καλημέρα</w:t>`, ""},
+		{"word/footer1.xml", `<w:ftr xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:p><w:r><w:t>σελίδα</w:t></w:r></w:p></w:ftr>`,
+			`<w:ftr xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:p><w:r><w:t>σε|λί|δα</w:t></w:r></w:p></w:ftr>`},
+	}
+
+	name := writeOfficeTestDocument(t, files)
+	if err := OfficeHyphenateFile(name, GetDefaultOptions()); err != nil {
+		t.Fatal(err)
+	}
+
+	checkOfficeTestDocument(t, name, files)
+}
+
+func TestOfficeHyphenateODT(t *testing.T) {
+	content := `<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0"><office:body><office:text>` +
+		`<text:tracked-changes><text:changed-region><text:deletion><text:p>καλημέρα</text:p></text:deletion></text:changed-region></text:tracked-changes>` +
+		`<text:h>Τίτλος</text:h><text:p><text:span text:style-name="T1">καλη</text:span>μέρα<text:note><text:note-citation>1</text:note-citation><text:note-body><text:p>σημείωση</text:p></text:note-body></text:note><text:s/>κόσμε<office:annotation><text:p>σχόλιο</text:p></office:annotation></text:p>` +
+		`</office:text></office:body></office:document-content>`
+
+	hyphenated := `<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0"><office:body><office:text>` +
+		`<text:tracked-changes><text:changed-region><text:deletion><text:p>καλημέρα</text:p></text:deletion></text:changed-region></text:tracked-changes>` +
+		`<text:h>Τίτ|λος</text:h><text:p><text:span text:style-name="T1">κα|λη|</text:span>μέ|ρα<text:note><text:note-citation>1</text:note-citation><text:note-body><text:p>ση|μεί|ω|ση</text:p></text:note-body></text:note><text:s/>κό|σμε<office:annotation><text:p>σχόλιο</text:p></office:annotation></text:p>` +
+		`</office:text></office:body></office:document-content>`
+
+	files := []officeTestFile{
+		{"mimetype", "application/vnd.oasis.opendocument.text", ""},
+		{"content.xml", content, hyphenated},
+		{"meta.xml", `<text:p xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0">καλημέρα</text:p>`, ""},
+	}
+
+	name := writeOfficeTestDocument(t, files)
+	if err := OfficeHyphenateFile(name, GetDefaultOptions()); err != nil {
+		t.Fatal(err)
+	}
+
+	checkOfficeTestDocument(t, name, files)
+
+	zr, err := zip.OpenReader(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer zr.Close()
+
+	if zr.File[0].Method != zip.Store {
+		t.Errorf("The mimetype entry is compressed")
+	}
+}
+
+func TestOfficeHyphenateUnsupported(t *testing.T) {
+	name := writeOfficeTestDocument(t, []officeTestFile{{"mimetype", "application/epub+zip", ""}})
+
+	if err := OfficeHyphenateFile(name, GetDefaultOptions()); !errors.Is(err, ErrUnsupportedDocument) {
+		t.Errorf("Expected ErrUnsupportedDocument, got %v", err)
+	}
+}
@@ -0,0 +1,344 @@
+package grhyph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PatternLevel configures one level of pattern generation, as a line of patgen's parameters does. Patterns
+// of MinLength to MaxLength letters (counting the "." word edges) are selected when
+// good*GoodWeight - bad*BadWeight >= Threshold, good and bad being the breaks that they would fix and break.
+type PatternLevel struct {
+	MinLength  int
+	MaxLength  int
+	GoodWeight int
+	BadWeight  int
+	Threshold  int
+}
+
+// DefaultPatternLevels alternate between hyphenating (odd) and inhibiting (even) levels.
+var DefaultPatternLevels = []PatternLevel{
+	{1, 3, 1, 1, 1},
+	{2, 4, 1, 1, 1},
+	{2, 5, 1, 1, 1},
+	{3, 6, 1, 1, 1},
+}
+
+// Patterns are Liang's hyphenation patterns, as used by TeX, libhyphen and other hyphenators.
+type Patterns struct {
+	// LeftMin and RightMin are the minimum number of letters kept at either end of a word, at least 1.
+	LeftMin  int
+	RightMin int
+
+	patterns  map[string][]int // The values of the positions around the letters of each pattern.
+	maxLength int
+}
+
+type (
+	// PatternReport tells how well the generated patterns reproduce the breaks of the Hyphenator, for the
+	// training words, after each level.
+	PatternReport struct {
+		Words  int
+		Breaks int
+		Levels []PatternLevelReport
+	}
+
+	PatternLevelReport struct {
+		Level    int
+		Patterns int // The patterns selected at the level.
+		PatternAccuracy
+	}
+
+	// PatternAccuracy counts the breaks that the patterns find (Good), find in excess (Bad) and miss (Missed).
+	PatternAccuracy struct {
+		Good   int
+		Bad    int
+		Missed int
+	}
+
+	patternWord struct {
+		letters []rune // The word between "." edges.
+		breaks  []bool // Whether the Hyphenator breaks before each of the letters.
+	}
+
+	patternCandidate struct {
+		letters string
+		dot     int
+	}
+)
+
+// GeneratePatterns hyphenates words with a new Hyphenator for o, and trains Liang patterns on the result.
+func GeneratePatterns(words []string, o Options, levels []PatternLevel) (*Patterns, PatternReport, error) {
+	h, err := NewHyphenatorWithCache(o, NoCache{})
+	if err != nil {
+		return nil, PatternReport{}, err
+	}
+
+	patterns, report := h.GeneratePatterns(words, levels)
+
+	return patterns, report, nil
+}
+
+// GeneratePatterns trains Liang patterns on words, as hyphenated by h, following patgen: each level adds the
+// patterns that fix more breaks than they break, as weighted by the level, with odd levels adding breaks and
+// even levels removing them. Words are lower cased, and those that TeX would not accept are skipped.
+func (h *Hyphenator) GeneratePatterns(words []string, levels []PatternLevel) (*Patterns, PatternReport) {
+	p := &Patterns{
+		LeftMin:  max(h.options.LeftMin, 1),
+		RightMin: max(h.options.RightMin, 1),
+		patterns: map[string][]int{},
+	}
+
+	trainingWords := h.patternWords(words)
+
+	report := PatternReport{Words: len(trainingWords)}
+	for _, word := range trainingWords {
+		for _, b := range word.breaks {
+			if b {
+				report.Breaks++
+			}
+		}
+	}
+
+	for i, level := range levels {
+		selected := 0
+		for length := max(level.MinLength, 1); length <= level.MaxLength; length++ {
+			selected += p.generateLevel(trainingWords, i+1, length, level)
+		}
+
+		report.Levels = append(report.Levels, PatternLevelReport{
+			Level:           i + 1,
+			Patterns:        selected,
+			PatternAccuracy: p.accuracy(trainingWords),
+		})
+	}
+
+	return p, report
+}
+
+// patternWords returns the distinct words that TeX would accept, with their breaks by h.
+func (h *Hyphenator) patternWords(words []string) []patternWord {
+	var (
+		patternWords []patternWord
+		seen         = map[string]bool{}
+	)
+
+	for _, word := range words {
+		word = strings.ToLower(word)
+		if seen[word] || !isTeXWord(word) {
+			continue
+		}
+		seen[word] = true
+
+		letters := []rune("." + word + ".")
+		breaks := make([]bool, len(letters)+1)
+		for _, b := range h.BreakPoints(word) {
+			breaks[b.RunePosition+1] = true
+		}
+
+		patternWords = append(patternWords, patternWord{letters, breaks})
+	}
+
+	return patternWords
+}
+
+// generateLevel selects the patterns of a length for a level, and returns how many were selected.
+func (p *Patterns) generateLevel(words []patternWord, level, length int, l PatternLevel) int {
+	counts := map[patternCandidate]*PatternAccuracy{}
+
+	for _, word := range words {
+		values := p.values(word.letters)
+
+		for i := range values {
+			if !p.breakable(i, len(word.letters)) {
+				continue
+			}
+
+			// Odd levels fix missing breaks and even levels fix excess breaks; positions that already have the
+			// parity of the level would not change.
+			if values[i]%2 == level%2 {
+				continue
+			}
+			good := word.breaks[i] == (level%2 == 1)
+
+			for start := max(i-length, 0); start <= i && start+length <= len(word.letters); start++ {
+				candidate := patternCandidate{string(word.letters[start : start+length]), i - start}
+
+				count := counts[candidate]
+				if count == nil {
+					count = &PatternAccuracy{}
+					counts[candidate] = count
+				}
+
+				if good {
+					count.Good++
+				} else {
+					count.Bad++
+				}
+			}
+		}
+	}
+
+	selected := 0
+	for candidate, count := range counts {
+		if count.Good*l.GoodWeight-count.Bad*l.BadWeight < l.Threshold {
+			continue
+		}
+
+		values, ok := p.patterns[candidate.letters]
+		if !ok {
+			values = make([]int, len([]rune(candidate.letters))+1)
+			p.patterns[candidate.letters] = values
+		}
+
+		values[candidate.dot] = max(values[candidate.dot], level)
+		p.maxLength = max(p.maxLength, len(values)-1)
+		selected++
+	}
+
+	return selected
+}
+
+// values returns the values of the positions of letters, as set by the patterns.
+func (p *Patterns) values(letters []rune) []int {
+	values := make([]int, len(letters)+1)
+
+	for start := range letters {
+		for end := start + 1; end <= len(letters) && end-start <= p.maxLength; end++ {
+			for i, v := range p.patterns[string(letters[start:end])] {
+				values[start+i] = max(values[start+i], v)
+			}
+		}
+	}
+
+	return values
+}
+
+// breakable tells whether position i of a word between "." edges, n letters long with the edges, may break.
+func (p *Patterns) breakable(i, n int) bool {
+	// Position i lies before the letter at i-1 of the word, which is n-2 letters long.
+	return i-1 >= p.LeftMin && n-2-(i-1) >= p.RightMin
+}
+
+func (p *Patterns) accuracy(words []patternWord) PatternAccuracy {
+	var accuracy PatternAccuracy
+
+	for _, word := range words {
+		values := p.values(word.letters)
+
+		for i, v := range values {
+			found := v%2 == 1 && p.breakable(i, len(word.letters))
+
+			switch {
+			case found && word.breaks[i]:
+				accuracy.Good++
+			case found:
+				accuracy.Bad++
+			case word.breaks[i]:
+				accuracy.Missed++
+			}
+		}
+	}
+
+	return accuracy
+}
+
+// Evaluate tells how well the patterns reproduce the breaks of h for words.
+func (p *Patterns) Evaluate(h *Hyphenator, words []string) PatternAccuracy {
+	return p.accuracy(h.patternWords(words))
+}
+
+// BreakPoints returns the rune positions at which the patterns break word, in increasing order.
+func (p *Patterns) BreakPoints(word string) []int {
+	letters := []rune("." + strings.ToLower(word) + ".")
+
+	var breaks []int
+	for i, v := range p.values(letters) {
+		if v%2 == 1 && p.breakable(i, len(letters)) {
+			breaks = append(breaks, i-1)
+		}
+	}
+
+	return breaks
+}
+
+// Len returns the number of patterns.
+func (p *Patterns) Len() int {
+	return len(p.patterns)
+}
+
+// Strings returns the patterns in the usual notation, e.g. "α1β", sorted by their letters.
+func (p *Patterns) Strings() []string {
+	letters := make([]string, 0, len(p.patterns))
+	for l := range p.patterns {
+		letters = append(letters, l)
+	}
+	sort.Strings(letters)
+
+	patterns := make([]string, len(letters))
+	for i, l := range letters {
+		var b strings.Builder
+
+		values := p.patterns[l]
+		for j, r := range []rune(l) {
+			if values[j] > 0 {
+				b.WriteString(strconv.Itoa(values[j]))
+			}
+			b.WriteRune(r)
+		}
+		if last := values[len(values)-1]; last > 0 {
+			b.WriteString(strconv.Itoa(last))
+		}
+
+		patterns[i] = b.String()
+	}
+
+	return patterns
+}
+
+// WriteText writes the patterns one per line, as the .pat.txt files of hyph-utf8 do.
+func (p *Patterns) WriteText(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, pattern := range p.Strings() {
+		bw.WriteString(pattern + "\n")
+	}
+
+	return bw.Flush()
+}
+
+// WriteTeX writes the patterns in the format of hyph-utf8's hyph-el files, i.e. a \patterns{...} block
+// preceded by comments.
+func (p *Patterns) WriteTeX(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	bw.WriteString("% Greek hyphenation patterns, generated by grhyph.\n")
+	fmt.Fprintf(bw, "%% lefthyphenmin %d, righthyphenmin %d\n", p.LeftMin, p.RightMin)
+	bw.WriteString("\\patterns{\n")
+	for _, pattern := range p.Strings() {
+		bw.WriteString(pattern + "\n")
+	}
+	bw.WriteString("}\n")
+
+	return bw.Flush()
+}
+
+func (r PatternReport) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%d words, %d breaks\n", r.Words, r.Breaks)
+	for _, level := range r.Levels {
+		fmt.Fprintf(&b, "level %d: %d patterns, %d good, %d bad, %d missed", level.Level, level.Patterns,
+			level.Good, level.Bad, level.Missed)
+		if r.Breaks > 0 {
+			fmt.Fprintf(&b, " (%.2f%% found, %.2f%% wrong)", 100*float64(level.Good)/float64(r.Breaks),
+				100*float64(level.Bad)/float64(r.Breaks))
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
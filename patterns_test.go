@@ -0,0 +1,83 @@
+package grhyph
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var patternTestWords = []string{
+	"άκαμπτος", "άλμπατρος", "έκθλιψη", "έκπληκτος", "έμπνευση", "ίντσα", "αεροελεγκτής", "αισχρολόγος",
+	"αλτρουισμός", "ανεξάντλητος", "αρθρογραφία", "ασύγγνωστος", "βούρτσα", "Δεκέμβριος", "διόπτρα",
+	"εγγλέζικος", "εισπνοή", "εκδρομή", "εκκρεμότητα", "εκπρόσωπος", "εκτρέφω", "εκφραστικός", "καλημέρα",
+	"κόσμε", "αηδόνια", "παιδιά", "ποιος", "τριανταφυλλιά", "ιστορία", "θάλασσα", "ουρανός", "μπαμπάς",
+}
+
+func TestGeneratePatterns(t *testing.T) {
+	for _, useGrhyphRules := range []bool{false, true} {
+		hyphenationOptions := GetDefaultOptions()
+		hyphenationOptions.UseGrhyphRules = useGrhyphRules
+
+		h, err := NewHyphenator(hyphenationOptions)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		patterns, report := h.GeneratePatterns(append(patternTestWords, "x2", "καλημέρα"), DefaultPatternLevels)
+
+		if report.Words != len(patternTestWords) || len(report.Levels) != len(DefaultPatternLevels) {
+			t.Fatalf("(rules: %t) Unexpected report:\n%s", useGrhyphRules, report)
+		}
+
+		last := report.Levels[len(report.Levels)-1].PatternAccuracy
+		if expected := (PatternAccuracy{Good: report.Breaks}); last != expected {
+			t.Errorf("(rules: %t) Accuracy does not match: expected %+v, got %+v", useGrhyphRules, expected, last)
+		}
+
+		if accuracy := patterns.Evaluate(h, patternTestWords); accuracy != last {
+			t.Errorf("(rules: %t) Evaluated accuracy does not match: expected %+v, got %+v", useGrhyphRules, last, accuracy)
+		}
+
+		for _, word := range patternTestWords {
+			var expected []int
+			for _, b := range h.BreakPoints(strings.ToLower(word)) {
+				expected = append(expected, b.RunePosition)
+			}
+
+			if got := patterns.BreakPoints(word); !reflect.DeepEqual(got, expected) {
+				t.Errorf("(%s) Break points do not match: expected %v, got %v", word, expected, got)
+			}
+		}
+	}
+}
+
+func TestPatternsWriteTeX(t *testing.T) {
+	p := &Patterns{
+		LeftMin:  1,
+		RightMin: 2,
+		patterns: map[string][]int{
+			"αβ":  {0, 1, 0},
+			".α":  {0, 0, 2},
+			"γδε": {3, 0, 0, 0},
+		},
+		maxLength: 3,
+	}
+
+	expected := "% Greek hyphenation patterns, generated by grhyph.\n% lefthyphenmin 1, righthyphenmin 2\n" +
+		"\\patterns{\n.α2\nα1β\n3γδε\n}\n"
+
+	var b bytes.Buffer
+	if err := p.WriteTeX(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	if b.String() != expected {
+		t.Errorf("Patterns do not match: expected %q, got %q", expected, b.String())
+	}
+
+	// ".α2" inhibits the break of "α1β".
+	if breaks := p.BreakPoints("αβγδε"); !reflect.DeepEqual(breaks, []int{2}) {
+		t.Errorf("Break points do not match: expected [2], got %v", breaks)
+	}
+}
@@ -0,0 +1,69 @@
+package grhyph
+
+// Result is the hyphenation of an input, with everything that went into it, for serialization.
+type Result struct {
+	Input     string     `json:"input"`
+	Output    string     `json:"output"`
+	Syllables []Syllable `json:"syllables"`
+	Options   Options    `json:"options"`
+
+	// Rules are the rules that matched the words of the input, when Options.UseGrhyphRules is set. They are
+	// traced as Output was hyphenated, i.e. with Options.RestoreAccents, on the accented words.
+	Rules []RuleMatch `json:"rules,omitempty"`
+}
+
+// RuleMatch is a rule that matched a word, or a part of a word that a rule left to be hyphenated recursively,
+// as traced by Explain.
+type RuleMatch struct {
+	Word   string           `json:"word"`  // The word as the rule matched it, with its accents restored.
+	Index  int              `json:"index"` // The index of the rule in the Hyphenator's rules.
+	Source GrhyphRuleSource `json:"source"`
+}
+
+// Result hyphenates s, and collects its syllables and, in rules mode, the rules that matched its words.
+func (h *Hyphenator) Result(s string) Result {
+	r := Result{
+		Input:     s,
+		Output:    h.Hyphenate(s),
+		Syllables: h.Syllabify(s),
+		Options:   h.options,
+	}
+
+	if r.Syllables == nil {
+		r.Syllables = []Syllable{}
+	}
+
+	if h.options.UseGrhyphRules && h.rulesMatchInput(s) {
+		for i, syllable := range r.Syllables {
+			if i+1 < len(r.Syllables) && r.Syllables[i+1].WordIndex == syllable.WordIndex {
+				continue
+			}
+
+			// Only the words that Output was rule-hyphenated from are traced.
+			speechSounds, _ := stringTospeechSounds(syllable.Word)
+			if syllable.End < len(s) && !h.rulesMatchWord(len(speechSounds)) {
+				continue
+			}
+
+			r.Rules = appendRuleMatches(r.Rules, h.Explain(syllable.Word))
+		}
+	}
+
+	return r
+}
+
+func appendRuleMatches(matches []RuleMatch, t *Trace) []RuleMatch {
+	if t == nil || t.Rule == nil {
+		return matches
+	}
+
+	word := t.Input
+	if t.Accented != "" {
+		word = t.Accented
+	}
+
+	matches = append(matches, RuleMatch{Word: word, Index: t.RuleIndex, Source: t.Rule.Source})
+	matches = appendRuleMatches(matches, t.LeftTrace)
+
+	return appendRuleMatches(matches, t.RightTrace)
+}
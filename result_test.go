@@ -0,0 +1,122 @@
+package grhyph
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestResultJSON(t *testing.T) {
+	hyphenationOptions := GetDefaultOptions()
+	hyphenationOptions.Separator = "-"
+	hyphenationOptions.UseGrhyphRules = true
+
+	h, err := NewHyphenator(hyphenationOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := h.Result("δυο αηδόνια")
+
+	if result.Output != "δυο αη-δό-νια" || len(result.Syllables) != 4 || len(result.Rules) == 0 {
+		t.Fatalf("Unexpected result: %+v", result)
+	}
+	if result.Rules[0].Word != "δυο" || result.Rules[0].Source.File != "grhyph.rules" {
+		t.Errorf("Unexpected rule match: %+v", result.Rules[0])
+	}
+
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		t.Fatal(err)
+	}
+
+	var keys []string
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if expected := []string{"input", "options", "output", "rules", "syllables"}; !reflect.DeepEqual(keys, expected) {
+		t.Errorf("JSON keys do not match: expected %v, got %v", expected, keys)
+	}
+
+	var decoded Result
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(decoded, result) {
+		t.Errorf("Decoded result does not match: expected %+v, got %+v", result, decoded)
+	}
+}
+
+func TestResultRestoreAccents(t *testing.T) {
+	hyphenationOptions := GetDefaultOptions()
+	hyphenationOptions.Separator = "-"
+	hyphenationOptions.UseGrhyphRules = true
+	hyphenationOptions.RestoreAccents = true
+
+	h, err := NewHyphenator(hyphenationOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Unaccented, "ελια" matches no rule; the rules match "ελιά".
+	result := h.Result("ελια")
+
+	if result.Output != "ε-λια" || len(result.Rules) == 0 {
+		t.Fatalf("Unexpected result: %+v", result)
+	}
+	if result.Rules[0].Word != "ελιά" {
+		t.Errorf("Rule match word does not match: expected ελιά, got %s", result.Rules[0].Word)
+	}
+}
+
+func TestBreakClassJSON(t *testing.T) {
+	b := Break{Position: 2, RunePosition: 1, Class: ConsonantClusterBreak}
+
+	encoded, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := `{"position":2,"rune_position":1,"class":"consonant-cluster"}`; string(encoded) != expected {
+		t.Errorf("Encoded break does not match: expected %s, got %s", expected, encoded)
+	}
+
+	var decoded Break
+	if err := json.Unmarshal(encoded, &decoded); err != nil || decoded != b {
+		t.Errorf("Decoded break does not match: expected %+v, got %+v (%v)", b, decoded, err)
+	}
+}
+
+func TestResultMinHyphenationLength(t *testing.T) {
+	hyphenationOptions := GetDefaultOptions()
+	hyphenationOptions.Separator = "-"
+	hyphenationOptions.UseGrhyphRules = true
+	hyphenationOptions.MinHyphenationLength = 4
+
+	h, err := NewHyphenator(hyphenationOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// "πιό" is shorter than MinHyphenationLength, so that no rule is matched against it.
+	if trace := h.Explain("πιό"); trace.Rule == nil {
+		t.Fatalf("Expected a rule to match πιό: %+v", trace)
+	}
+
+	result := h.Result("πιό καλά")
+
+	for _, match := range result.Rules {
+		if match.Word == "πιό" {
+			t.Errorf("Unexpected rule match: %+v", match)
+		}
+	}
+}
@@ -0,0 +1,324 @@
+package grhyph
+
+import (
+	"bufio"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type (
+	GrhyphRule struct {
+		CompiledCustomRe *regexp.Regexp
+		Replacement      string
+		Source           GrhyphRuleSource
+	}
+
+	// GrhyphRuleSource is the definition of a GrhyphRule: a list of tokens, each a key of customRegexpsMap,
+	// and a replacement where ">" and "<" delimit the part that is not hyphenated recursively, and "-"
+	// marks a break. See grhyph.rules for the text format.
+	GrhyphRuleSource struct {
+		Tokens      []string `json:"tokens"`
+		Replacement string   `json:"replacement"`
+		Comment     string   `json:"comment,omitempty"` // The comment lines right above the rule, usually example words.
+
+		// File and Line locate the definition, when known.
+		File string `json:"file,omitempty"`
+		Line int    `json:"line,omitempty"`
+	}
+
+	// RuleError describes a rule that failed to compile.
+	RuleError struct {
+		Index  int    // The index of the rule in its rule set.
+		Token  string // The offending token, if the error is about a single token.
+		Source GrhyphRuleSource
+		Err    error
+	}
+
+	// RuleErrors collects the errors of every rule in a rule set that failed to compile.
+	RuleErrors []*RuleError
+)
+
+var (
+	ErrUnknownToken       = errors.New("custom regexp not found in map")
+	ErrInvalidRegexp      = errors.New("invalid regexp")
+	ErrInvalidReplacement = errors.New("invalid replacement")
+	ErrSyntax             = errors.New("syntax error")
+)
+
+func (e *RuleError) Error() string {
+	var location string
+	if e.Source.File != "" {
+		location = fmt.Sprintf("%s:%d: ", e.Source.File, e.Source.Line)
+	} else if e.Source.Line > 0 {
+		location = fmt.Sprintf("line %d: ", e.Source.Line)
+	}
+
+	if e.Token != "" {
+		return fmt.Sprintf("%srule %d: %v: %q", location, e.Index, e.Err, e.Token)
+	}
+
+	return fmt.Sprintf("%srule %d: %v", location, e.Index, e.Err)
+}
+
+func (e *RuleError) Unwrap() error {
+	return e.Err
+}
+
+func (e RuleErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, ruleError := range e {
+		messages[i] = ruleError.Error()
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// grhyphRulesFile holds the built-in rules.
+//
+//go:embed grhyph.rules
+var grhyphRulesFile string
+
+// GrhyphRules are the compiled built-in rules. Rules that fail to compile are left out, and are reported
+// by ValidateRules.
+var GrhyphRules, grhyphRulesErr = loadRules(strings.NewReader(grhyphRulesFile), "grhyph.rules")
+
+// ValidateRules reports the built-in rules that failed to compile, as RuleErrors.
+func ValidateRules() error {
+	return grhyphRulesErr
+}
+
+// LoadRules reads and compiles rules in the grhyph.rules text format. The rules that fail to parse or
+// compile are left out of the result, and their errors are returned as RuleErrors; other errors come
+// from reading r.
+func LoadRules(r io.Reader) ([]GrhyphRule, error) {
+	return loadRules(r, "")
+}
+
+// LoadRulesFile is like LoadRules, reading from the file at path.
+func LoadRulesFile(path string) ([]GrhyphRule, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return loadRules(f, filepath.Base(path))
+}
+
+func loadRules(r io.Reader, file string) ([]GrhyphRule, error) {
+	sources, parseErrors, err := ParseRules(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range sources {
+		sources[i].File = file
+	}
+
+	rules, err := CompileRules(sources)
+	if len(parseErrors) == 0 {
+		return rules, err
+	}
+
+	for _, parseError := range parseErrors {
+		parseError.Source.File = file
+	}
+
+	// Rule indexes count every rule line, including the ones that failed to parse.
+	if compileErrors, ok := err.(RuleErrors); ok {
+		ordinals := make([]int, 0, len(sources))
+		for ordinal, p := 0, 0; len(ordinals) < len(sources); ordinal++ {
+			if p < len(parseErrors) && parseErrors[p].Index == ordinal {
+				p++
+				continue
+			}
+			ordinals = append(ordinals, ordinal)
+		}
+
+		for _, compileError := range compileErrors {
+			compileError.Index = ordinals[compileError.Index]
+		}
+
+		parseErrors = append(parseErrors, compileErrors...)
+		sort.Slice(parseErrors, func(i, j int) bool { return parseErrors[i].Index < parseErrors[j].Index })
+	}
+
+	return rules, parseErrors
+}
+
+// ParseRules reads rule sources in the grhyph.rules text format, without compiling them. Lines that are not
+// valid rules are reported as ruleErrors, while err is a read error.
+func ParseRules(r io.Reader) (sources []GrhyphRuleSource, ruleErrors RuleErrors, err error) {
+	var comment []string
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+
+		if text == "" {
+			comment = nil
+			continue
+		}
+
+		if strings.HasPrefix(text, "#") {
+			comment = append(comment, strings.TrimSpace(strings.TrimPrefix(text, "#")))
+			continue
+		}
+
+		source, err := parseRuleLine(text)
+		source.Comment = strings.Join(comment, "\n")
+		source.Line = line
+		comment = nil
+
+		if err != nil {
+			ruleErrors = append(ruleErrors, &RuleError{Index: len(sources) + len(ruleErrors), Source: source,
+				Err: fmt.Errorf("%w: %v", ErrSyntax, err)})
+			continue
+		}
+
+		sources = append(sources, source)
+	}
+
+	return sources, ruleErrors, scanner.Err()
+}
+
+// parseRuleLine parses `token token... => replacement [# comment]`.
+func parseRuleLine(text string) (GrhyphRuleSource, error) {
+	var (
+		source    GrhyphRuleSource
+		fields    []string
+		arrowSeen bool
+	)
+
+	for {
+		text = strings.TrimLeft(text, " \t")
+		if text == "" || strings.HasPrefix(text, "#") {
+			break
+		}
+
+		var field string
+		if strings.HasPrefix(text, `"`) {
+			quoted, err := strconv.QuotedPrefix(text)
+			if err != nil {
+				return source, fmt.Errorf("unterminated quoted token: %s", text)
+			}
+
+			field, _ = strconv.Unquote(quoted)
+			text = text[len(quoted):]
+		} else {
+			end := strings.IndexAny(text, " \t")
+			if end < 0 {
+				end = len(text)
+			}
+
+			field, text = text[:end], text[end:]
+			if field == "=>" && !arrowSeen {
+				arrowSeen = true
+				source.Tokens = fields
+				fields = nil
+				continue
+			}
+		}
+
+		fields = append(fields, field)
+	}
+
+	switch {
+	case !arrowSeen:
+		return source, errors.New(`missing "=>"`)
+	case len(source.Tokens) == 0:
+		return source, errors.New("missing tokens")
+	case len(fields) != 1:
+		return source, errors.New("expected a single replacement")
+	}
+
+	source.Replacement = fields[0]
+
+	return source, nil
+}
+
+// CompileRules compiles every source. The rules that fail to compile are left out of the result, and their
+// errors are returned as RuleErrors.
+func CompileRules(sources []GrhyphRuleSource) ([]GrhyphRule, error) {
+	var (
+		rules      = make([]GrhyphRule, 0, len(sources))
+		ruleErrors RuleErrors
+	)
+
+	for i, source := range sources {
+		rule, err := CompileRule(i, source)
+		if err != nil {
+			ruleErrors = append(ruleErrors, err.(*RuleError))
+			continue
+		}
+
+		rules = append(rules, rule)
+	}
+
+	if len(ruleErrors) > 0 {
+		return rules, ruleErrors
+	}
+
+	return rules, nil
+}
+
+var replacementGroupRe = regexp.MustCompile(`\$(\d+)`)
+
+// CompileRule transforms the tokens of source into the canonical regexp format, and compiles the result.
+// Errors are of type *RuleError, index being the rule's position in its rule set.
+func CompileRule(index int, source GrhyphRuleSource) (GrhyphRule, error) {
+	ruleError := func(token string, err error) (GrhyphRule, error) {
+		return GrhyphRule{}, &RuleError{Index: index, Token: token, Source: source, Err: err}
+	}
+
+	var canonRegexp []byte
+	canonRegexp = append(canonRegexp, "(?i)"...)
+
+	for _, grhyphRegexp := range source.Tokens {
+		mappedRe, ok := customRegexpsMap[grhyphRegexp]
+		if !ok {
+			return ruleError(grhyphRegexp, ErrUnknownToken)
+		}
+
+		canonRegexp = append(canonRegexp, mappedRe...)
+	}
+
+	compiledCustomRe, err := regexp.Compile(string(canonRegexp[:]))
+	if err != nil {
+		return ruleError("", fmt.Errorf("%w: %v", ErrInvalidRegexp, err))
+	}
+
+	// Only group references and the "-", ">" and "<" markers may appear in the replacement.
+	replacement := source.Replacement
+	if strings.Trim(replacementGroupRe.ReplaceAllString(replacement, ""), "-><") != "" {
+		return ruleError("", fmt.Errorf("%w: unexpected characters in %q", ErrInvalidReplacement, replacement))
+	}
+
+	left, right := strings.Index(replacement, ">"), strings.Index(replacement, "<")
+	if strings.Count(replacement, ">") > 1 || strings.Count(replacement, "<") > 1 ||
+		(left >= 0 && right >= 0 && right < left) {
+		return ruleError("", fmt.Errorf("%w: misplaced > or < in %q", ErrInvalidReplacement, replacement))
+	}
+
+	for _, group := range replacementGroupRe.FindAllStringSubmatch(replacement, -1) {
+		if n, _ := strconv.Atoi(group[1]); n < 1 || n > compiledCustomRe.NumSubexp() {
+			return ruleError(group[0], fmt.Errorf("%w: no such group", ErrInvalidReplacement))
+		}
+	}
+
+	return GrhyphRule{
+		CompiledCustomRe: compiledCustomRe,
+		Replacement:      replacement,
+		Source:           source,
+	}, nil
+}
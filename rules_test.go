@@ -0,0 +1,125 @@
+package grhyph
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateRules(t *testing.T) {
+	if err := ValidateRules(); err != nil {
+		t.Errorf("Built-in rules do not compile:\n%v", err)
+	}
+
+	if len(GrhyphRules) != 1474 {
+		t.Errorf("Expected %d compiled rules, got %d", 1474, len(GrhyphRules))
+	}
+
+	if GrhyphRules[0].Source.File != "grhyph.rules" || GrhyphRules[0].Source.Line == 0 {
+		t.Errorf("Rule source location is not recorded, got %s:%d", GrhyphRules[0].Source.File,
+			GrhyphRules[0].Source.Line)
+	}
+}
+
+type ruleErrorTest struct {
+	source GrhyphRuleSource
+	token  string
+	err    error
+}
+
+func TestCompileRules(t *testing.T) {
+	tests := []ruleErrorTest{
+		{GrhyphRuleSource{Tokens: []string{"(.*)", "(χ)", "(.*)"}, Replacement: "$1>-<$2$3"}, "", nil},
+		{GrhyphRuleSource{Tokens: []string{"(.*)", "(ψψ)", "(.*)"}, Replacement: "$1>-<$2$3"}, "(ψψ)", ErrUnknownToken},
+		{GrhyphRuleSource{Tokens: []string{"(.*)", "(χ)", "(.*)"}, Replacement: "$1>-<$2$4"}, "$4", ErrInvalidReplacement},
+		{GrhyphRuleSource{Tokens: []string{"(.*)", "(χ)", "(.*)"}, Replacement: "$1<-<$2$3"}, "", ErrInvalidReplacement},
+		{GrhyphRuleSource{Tokens: []string{"(.*)", "(χ)", "(.*)"}, Replacement: "$1<->$2$3"}, "", ErrInvalidReplacement},
+		{GrhyphRuleSource{Tokens: []string{"(.*)", "(χ)", "(.*)"}, Replacement: "$1>/<$2$3"}, "", ErrInvalidReplacement},
+	}
+
+	for i, test := range tests {
+		test.source.Line = 10 + i
+
+		_, err := CompileRule(i, test.source)
+		if !errors.Is(err, test.err) {
+			t.Errorf("(%v) Expected error %v, got %v", test.source.Tokens, test.err, err)
+			continue
+		}
+		if err == nil {
+			continue
+		}
+
+		var ruleError *RuleError
+		if !errors.As(err, &ruleError) || ruleError.Index != i || ruleError.Token != test.token ||
+			ruleError.Source.Line != test.source.Line {
+			t.Errorf("(%v) Rule error does not locate the rule: %#v", test.source.Tokens, err)
+		}
+	}
+
+	var sources []GrhyphRuleSource
+	for _, test := range tests {
+		sources = append(sources, test.source)
+	}
+
+	rules, err := CompileRules(sources)
+
+	var ruleErrors RuleErrors
+	if !errors.As(err, &ruleErrors) || len(ruleErrors) != len(tests)-1 || len(rules) != 1 {
+		t.Errorf("Expected 1 compiled rule and %d errors, got %d rules and %v", len(tests)-1, len(rules), err)
+	}
+}
+
+func TestLoadRules(t *testing.T) {
+	rulesText := `# A comment that is not attached to any rule.
+
+# ισοπαλία (ισοπαλι-α)
+(.*) (ο) (π) (α) (λ) (ι) "(ας|ες|ων|ών|α)" $ => $1$2>-$3$4-$5$6-$7  # Trailing comment.
+(.*) (χ) (.*) $1$2$3
+(.*) (ψψ) (.*) => $1$2$3
+^ (π) (ι) (ο|ό) $ => $1$2$3
+`
+
+	rules, err := LoadRules(strings.NewReader(rulesText))
+
+	var ruleErrors RuleErrors
+	if !errors.As(err, &ruleErrors) || len(ruleErrors) != 2 {
+		t.Fatalf("Expected 2 rule errors, got %v", err)
+	}
+
+	if !errors.Is(ruleErrors[0], ErrSyntax) || ruleErrors[0].Index != 1 || ruleErrors[0].Source.Line != 5 {
+		t.Errorf("Syntax error does not locate the rule: %v", ruleErrors[0])
+	}
+
+	if !errors.Is(ruleErrors[1], ErrUnknownToken) || ruleErrors[1].Index != 2 || ruleErrors[1].Source.Line != 6 {
+		t.Errorf("Unknown token error does not locate the rule: %v", ruleErrors[1])
+	}
+
+	if len(rules) != 2 {
+		t.Fatalf("Expected 2 compiled rules, got %d", len(rules))
+	}
+
+	if rules[0].Source.Comment != "ισοπαλία (ισοπαλι-α)" || rules[0].Source.Line != 4 {
+		t.Errorf("Rule comment or line do not match, got %q at line %d", rules[0].Source.Comment, rules[0].Source.Line)
+	}
+
+	hyphenationOptions := GetDefaultOptions()
+
+	hyphenationOptions.Separator = "-"
+	hyphenationOptions.UseGrhyphRules = true
+
+	h, err := NewHyphenatorWithRules(hyphenationOptions, rules, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []hyphenationTest{
+		{"ισοπαλία", "ι-σο-πα-λί-α"},
+		{"πιο", "πιο"},
+	}
+
+	for _, test := range tests {
+		if hyphenedText := h.Hyphenate(test.input); hyphenedText != test.hyphenated {
+			t.Errorf("(%s) Hyphenated value does not match: expected %s, got %s", test.input, test.hyphenated, hyphenedText)
+		}
+	}
+}
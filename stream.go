@@ -0,0 +1,112 @@
+package grhyph
+
+import (
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// wordBoundaries are the characters of the SpeechSoundRe punctuation group, "\s" being ASCII whitespace.
+const wordBoundaries = "\t\n\f\r .,-–—―/'’\":!?;&@«»"
+
+func isWordBoundary(r rune) bool {
+	return strings.ContainsRune(wordBoundaries, r)
+}
+
+// Writer hyphenates the text written to it, one word at a time, and writes the result to the underlying
+// io.Writer. Only the current word is buffered; whitespace and punctuation are written as they are.
+// Close must be called to flush the last word.
+type Writer struct {
+	h   *Hyphenator
+	w   io.Writer
+	buf []byte // The current word, possibly ending in an incomplete UTF-8 sequence.
+	out []byte
+	err error
+}
+
+// NewWriter returns a Writer that hyphenates with a new Hyphenator for o.
+func NewWriter(w io.Writer, o Options) (*Writer, error) {
+	h, err := NewHyphenator(o)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.NewWriter(w), nil
+}
+
+// NewWriter returns a Writer that hyphenates with h.
+func (h *Hyphenator) NewWriter(w io.Writer) *Writer {
+	return &Writer{h: h, w: w}
+}
+
+// Write hyphenates every word of p that is complete, and buffers the rest.
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+
+	w.buf = append(w.buf, p...)
+	w.out = w.out[:0]
+
+	wordStart := 0
+	for i := 0; i < len(w.buf); {
+		if !utf8.FullRune(w.buf[i:]) {
+			break // Wait for the rest of the rune.
+		}
+
+		r, size := utf8.DecodeRune(w.buf[i:])
+		if isWordBoundary(r) {
+			w.out = append(w.out, w.h.Hyphenate(string(w.buf[wordStart:i]))...)
+			w.out = append(w.out, w.buf[i:i+size]...)
+			wordStart = i + size
+		}
+
+		i += size
+	}
+
+	w.buf = w.buf[:copy(w.buf, w.buf[wordStart:])]
+
+	if len(w.out) > 0 {
+		if _, w.err = w.w.Write(w.out); w.err != nil {
+			return 0, w.err
+		}
+	}
+
+	return len(p), nil
+}
+
+// Close hyphenates and writes the buffered word. It does not close the underlying io.Writer.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+
+	if len(w.buf) > 0 {
+		_, w.err = io.WriteString(w.w, w.h.Hyphenate(string(w.buf)))
+		w.buf = w.buf[:0]
+	}
+
+	return w.err
+}
+
+// Copy hyphenates src into dst, with a new Hyphenator for o. It returns the number of bytes read from src.
+func Copy(dst io.Writer, src io.Reader, o Options) (int64, error) {
+	h, err := NewHyphenator(o)
+	if err != nil {
+		return 0, err
+	}
+
+	return h.Copy(dst, src)
+}
+
+// Copy hyphenates src into dst. It returns the number of bytes read from src.
+func (h *Hyphenator) Copy(dst io.Writer, src io.Reader) (int64, error) {
+	w := h.NewWriter(dst)
+
+	n, err := io.Copy(w, src)
+	if err != nil {
+		return n, err
+	}
+
+	return n, w.Close()
+}
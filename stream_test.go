@@ -0,0 +1,51 @@
+package grhyph
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWriter(t *testing.T) {
+	text := "Μια και δυο. Μία και δύο,\n\t«αηδόνια» — aidiniwtis/anthia; παλιόπαλιοpalio "
+
+	for _, useGrhyphRules := range []bool{false, true} {
+		hyphenationOptions := GetDefaultOptions()
+
+		hyphenationOptions.Separator = "-"
+		hyphenationOptions.UseGrhyphRules = useGrhyphRules
+
+		h, err := NewHyphenator(hyphenationOptions)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := h.Hyphenate(text)
+
+		// Write a byte at a time, so that runes are split across writes.
+		var b bytes.Buffer
+		w := h.NewWriter(&b)
+		for i := 0; i < len(text); i++ {
+			if _, err := w.Write([]byte{text[i]}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		if b.String() != expected {
+			t.Errorf("(rules: %t) Streamed value does not match: expected %q, got %q", useGrhyphRules, expected, b.String())
+		}
+
+		b.Reset()
+		if n, err := Copy(&b, strings.NewReader(text+"αλκμιόνη"), hyphenationOptions); err != nil ||
+			n != int64(len(text+"αλκμιόνη")) {
+			t.Fatalf("Copy failed after %d bytes: %v", n, err)
+		}
+
+		if expected += h.Hyphenate("αλκμιόνη"); b.String() != expected {
+			t.Errorf("(rules: %t) Copied value does not match: expected %q, got %q", useGrhyphRules, expected, b.String())
+		}
+	}
+}
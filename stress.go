@@ -0,0 +1,293 @@
+package grhyph
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// StressClass classifies a word by the position of its accent.
+type StressClass int
+
+const (
+	// Unstressed words have no accent, e.g. monosyllables, or an accent beyond the antepenult.
+	Unstressed StressClass = iota
+	// Oxytone words are stressed on the last syllable, e.g. "καλός".
+	Oxytone
+	// Paroxytone words are stressed on the penult, e.g. "σπίτι".
+	Paroxytone
+	// Proparoxytone words are stressed on the antepenult, e.g. "άνθρωπος".
+	Proparoxytone
+)
+
+var stressClassNames = [...]string{"unstressed", "oxytone", "paroxytone", "proparoxytone"}
+
+func (c StressClass) String() string {
+	if c < 0 || int(c) >= len(stressClassNames) {
+		return "unknown"
+	}
+
+	return stressClassNames[c]
+}
+
+// MarshalText encodes the class by its name, e.g. "paroxytone".
+func (c StressClass) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+func (c *StressClass) UnmarshalText(text []byte) error {
+	for class, name := range stressClassNames {
+		if name == string(text) {
+			*c = StressClass(class)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown stress class %q", text)
+}
+
+// StressError is an orthographic error of the accents of a word.
+type StressError int
+
+const (
+	// AccentBeyondAntepenult is an accent before the last three syllables, e.g. "έπαιξαμε".
+	AccentBeyondAntepenult StressError = iota
+	// MissingAccent is a polysyllable without an accent, e.g. "σπιτι". Words in uppercase take no accents.
+	MissingAccent
+	// DoubleAccent is a word of more than one accent, other than a proparoxytone followed by an enclitic,
+	// e.g. "άνθρωπός μου".
+	DoubleAccent
+	// MissingEncliticAccent is a proparoxytone followed by an enclitic pronoun without the accent it takes
+	// on its last syllable, e.g. "άνθρωπος μου".
+	MissingEncliticAccent
+)
+
+var stressErrorNames = [...]string{"accent-beyond-antepenult", "missing-accent", "double-accent",
+	"missing-enclitic-accent"}
+
+func (e StressError) String() string {
+	if e < 0 || int(e) >= len(stressErrorNames) {
+		return "unknown"
+	}
+
+	return stressErrorNames[e]
+}
+
+// MarshalText encodes the error by its name, e.g. "missing-accent".
+func (e StressError) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+func (e *StressError) UnmarshalText(text []byte) error {
+	for stressError, name := range stressErrorNames {
+		if name == string(text) {
+			*e = StressError(stressError)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown stress error %q", text)
+}
+
+// StressAnalysis is the stress of a word.
+type StressAnalysis struct {
+	Word string `json:"word"`
+
+	// Start and End are the byte offsets of Word into the text of StressText.
+	Start int `json:"start"`
+	End   int `json:"end"`
+
+	Syllables int `json:"syllables"`
+
+	// Stressed is the index of the stressed syllable, or of the first of them, or -1 for none.
+	Stressed int         `json:"stressed"`
+	Class    StressClass `json:"class"`
+
+	// EncliticAccent tells that a proparoxytone takes a second accent on its last syllable, as it does
+	// before an enclitic.
+	EncliticAccent bool `json:"enclitic_accent"`
+
+	Errors []StressError `json:"errors,omitempty"`
+}
+
+// enclitics are the weak pronouns that take the stress of a proparoxytone before them to its last syllable,
+// and strictEnclitics those of them that are not articles too, and always do so.
+var (
+	enclitics = map[string]bool{
+		"μου": true, "σου": true, "του": true, "της": true, "μας": true, "σας": true, "τους": true,
+		"τον": true, "την": true, "τη": true, "το": true, "τα": true, "τις": true, "τες": true,
+	}
+	strictEnclitics = map[string]bool{"μου": true, "σου": true, "μας": true, "σας": true}
+)
+
+// Stress finds the stressed syllable of a word, and its orthographic errors. Syllables are counted by the
+// vowels of stringTospeechSounds; vowels prone to synizesis make a single syllable, unless the first of them
+// is accented or the second has a diaeresis, e.g. "καρ-διά", but "τσά-ι" and "λα-ϊ-κός", or GrhyphRules
+// split or join them, e.g. "σκι-ά" and "χάι-δε-μα". Monosyllables are oxytone when accented. Without the words around it, a double accent is always an error; see StressText.
+func Stress(word string) StressAnalysis {
+	a := StressAnalysis{Word: word, End: len(word), Stressed: -1}
+
+	accents := syllableAccents(word)
+	a.Syllables = len(accents)
+
+	var stressed []int
+	for i, accented := range accents {
+		if accented {
+			stressed = append(stressed, i)
+		}
+	}
+
+	if len(stressed) == 0 {
+		upper := strings.IndexFunc(word, unicode.IsLower) < 0
+		if a.Syllables > 1 && !upper && strings.IndexFunc(word, isLatinLetter) < 0 {
+			a.Errors = append(a.Errors, MissingAccent)
+		}
+		return a
+	}
+
+	a.Stressed = stressed[0]
+	switch a.Syllables - 1 - a.Stressed {
+	case 0:
+		a.Class = Oxytone
+	case 1:
+		a.Class = Paroxytone
+	case 2:
+		a.Class = Proparoxytone
+	default:
+		a.Errors = append(a.Errors, AccentBeyondAntepenult)
+	}
+
+	if len(stressed) > 1 {
+		a.EncliticAccent = len(stressed) == 2 && a.Class == Proparoxytone && stressed[1] == a.Syllables-1
+		a.Errors = append(a.Errors, DoubleAccent)
+	}
+
+	return a
+}
+
+// StressText analyses the stress of the words of text, as Stress does, taking the enclitic pronouns after them
+// into account: a proparoxytone followed by one, with whitespace only between them, takes a second accent.
+func StressText(text string) []StressAnalysis {
+	var analyses []StressAnalysis
+
+	start := -1
+	for i, r := range text + " " {
+		if !isWordBoundary(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+
+		if start >= 0 {
+			a := Stress(text[start:i])
+			a.Start, a.End = start, i
+			analyses = append(analyses, a)
+			start = -1
+		}
+	}
+
+	for i := range analyses {
+		a := &analyses[i]
+
+		var next string
+		if i+1 < len(analyses) && strings.TrimSpace(text[a.End:analyses[i+1].Start]) == "" {
+			next = strings.ToLower(analyses[i+1].Word)
+		}
+
+		switch {
+		case a.EncliticAccent && enclitics[next]:
+			a.Errors = removeStressError(a.Errors, DoubleAccent)
+		case a.Class == Proparoxytone && !a.EncliticAccent && strictEnclitics[next]:
+			a.Errors = append(a.Errors, MissingEncliticAccent)
+		}
+	}
+
+	return analyses
+}
+
+func removeStressError(errors []StressError, e StressError) []StressError {
+	var kept []StressError
+	for _, stressError := range errors {
+		if stressError != e {
+			kept = append(kept, stressError)
+		}
+	}
+
+	return kept
+}
+
+// stressHyphenator hyphenates words with GrhyphRules for syllableAccents, whose rules tell apart vowels that
+// are always a syllable of their own, e.g. "σκι-ά", from those that are always joined, e.g. "πιό".
+var stressHyphenator = sync.OnceValue(func() *Hyphenator {
+	o := GetDefaultOptions()
+	o.UseGrhyphRules = true
+	o.MinHyphenationLength = 1
+
+	h, err := NewHyphenatorWithRules(o, GrhyphRules, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return h
+})
+
+// syllableAccents tells, for each syllable of word, whether it is accented. Adjacent vowels split by a rule or a
+// plain syllable break make two syllables, and those that the rules join make one. Vowels separated by a break
+// of class SynizesisBreak make one syllable, unless the first is accented or the second has a diaeresis.
+func syllableAccents(word string) []bool {
+	speechSounds, _ := stringTospeechSounds(word)
+
+	var (
+		accents []bool
+		breaks  map[int]BreakClass // The breaks of stressHyphenator, found once adjacent vowels are.
+		offset  int
+	)
+	for i, speechSound := range speechSounds {
+		start := offset
+		offset += len(speechSound.Match)
+
+		if speechSound.Group != "vowels" {
+			continue
+		}
+
+		accented, _ := vowelDiacritics(speechSound.Match)
+
+		if i > 0 && speechSounds[i-1].Group == "vowels" {
+			previous := speechSounds[i-1].Match
+			previousAccented, _ := vowelDiacritics(previous)
+			_, diaeresis := vowelDiacritics(speechSound.Match)
+
+			if breaks == nil {
+				breaks = map[int]BreakClass{}
+				for _, b := range stressHyphenator().BreakPoints(word) {
+					breaks[b.Position] = b.Class
+				}
+			}
+
+			class, split := breaks[start]
+			if !split || class == SynizesisBreak && !previousAccented && !diaeresis &&
+				synizesisVowelsRe.MatchString(previous+speechSound.Match) {
+				accents[len(accents)-1] = accents[len(accents)-1] || accented
+				continue
+			}
+		}
+
+		accents = append(accents, accented)
+	}
+
+	return accents
+}
+
+// vowelDiacritics tells whether the letters of a vowel have an accent, and whether they have a diaeresis.
+func vowelDiacritics(vowel string) (acute, diaeresis bool) {
+	for _, r := range vowel {
+		if accent, ok := greekAccents[unicode.ToLower(r)]; ok {
+			acute = acute || accent.acute
+			diaeresis = diaeresis || accent.diaeresis
+		}
+	}
+
+	return acute, diaeresis
+}
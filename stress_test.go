@@ -0,0 +1,82 @@
+package grhyph
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestStress(t *testing.T) {
+	tests := []struct {
+		word      string
+		syllables int
+		stressed  int
+		class     StressClass
+		errors    []StressError
+	}{
+		{"καλός", 2, 1, Oxytone, nil},
+		{"σπίτι", 2, 0, Paroxytone, nil},
+		{"άνθρωπος", 3, 0, Proparoxytone, nil},
+		{"καρδιά", 2, 1, Oxytone, nil},
+		{"ακρίβεια", 3, 1, Paroxytone, nil},
+		{"τσάι", 2, 0, Paroxytone, nil},
+		{"σκιά", 2, 1, Oxytone, nil},
+		{"χάιδεμα", 3, 0, Proparoxytone, nil},
+		{"κορόιδεψα", 4, 1, Proparoxytone, nil},
+		{"λαϊκός", 3, 2, Oxytone, nil},
+		{"Αγλαΐα", 4, 2, Paroxytone, nil},
+		{"πού", 1, 0, Oxytone, nil},
+		{"ποιος", 1, -1, Unstressed, nil},
+		{"ΑΝΘΡΩΠΟΣ", 3, -1, Unstressed, nil},
+		{"έπαιξαμε", 4, 0, Unstressed, []StressError{AccentBeyondAntepenult}},
+		{"σπιτι", 2, -1, Unstressed, []StressError{MissingAccent}},
+		{"άνθρωπός", 3, 0, Proparoxytone, []StressError{DoubleAccent}},
+		{"κάλός", 2, 0, Paroxytone, []StressError{DoubleAccent}},
+	}
+
+	for _, test := range tests {
+		a := Stress(test.word)
+		if a.Syllables != test.syllables || a.Stressed != test.stressed || a.Class != test.class ||
+			!reflect.DeepEqual(a.Errors, test.errors) {
+			t.Errorf("(%s) Stress does not match: expected %d %d %s %v, got %d %d %s %v", test.word, test.syllables,
+				test.stressed, test.class, test.errors, a.Syllables, a.Stressed, a.Class, a.Errors)
+		}
+	}
+}
+
+func TestStressText(t *testing.T) {
+	text := "Ο άνθρωπός μου, το όνομα μου και ο δάσκαλος της τάξης. Άνθρωπός, μου"
+
+	expected := map[string][]StressError{
+		"άνθρωπός": nil,
+		"όνομα":    {MissingEncliticAccent},
+		"δάσκαλος": nil,
+		"Άνθρωπός": {DoubleAccent},
+	}
+
+	for _, a := range StressText(text) {
+		if text[a.Start:a.End] != a.Word {
+			t.Errorf("(%s) Offsets do not match: got %q", a.Word, text[a.Start:a.End])
+		}
+
+		if errors, ok := expected[a.Word]; ok && !reflect.DeepEqual(a.Errors, errors) {
+			t.Errorf("(%s) Stress errors do not match: expected %v, got %v", a.Word, errors, a.Errors)
+		}
+	}
+}
+
+func TestStressJSON(t *testing.T) {
+	b, err := json.Marshal(Stress("έπαιξαμε"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var a StressAnalysis
+	if err := json.Unmarshal(b, &a); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(a, Stress("έπαιξαμε")) {
+		t.Errorf("(έπαιξαμε) Stress does not round trip: %s", b)
+	}
+}
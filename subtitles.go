@@ -0,0 +1,390 @@
+package grhyph
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidSubtitles = errors.New("invalid subtitles")
+
+// SubtitleFormat is the file format of Subtitles.
+type SubtitleFormat int
+
+const (
+	SRT SubtitleFormat = iota
+	WebVTT
+)
+
+type (
+	// Subtitles are the cues of an SRT or a WebVTT file.
+	Subtitles struct {
+		Format SubtitleFormat
+
+		// Header is the rest of the WebVTT signature line, and the header lines that follow it.
+		Header string
+
+		Cues []*Cue
+	}
+
+	// Cue is a timed block of subtitle text. WebVTT NOTE, STYLE and REGION blocks are kept verbatim in
+	// Block, and have no other fields set.
+	Cue struct {
+		ID       string
+		Start    time.Duration
+		End      time.Duration
+		Settings string // WebVTT cue settings, or SRT coordinates, following the timings.
+		Lines    []string
+
+		Block string
+	}
+)
+
+var (
+	subtitleTimingRe    = regexp.MustCompile(`^\s*(\S+)\s+-->\s+(\S+)\s*(.*)$`)
+	subtitleTimestampRe = regexp.MustCompile(`^(?:(\d+):)?(\d{2}):(\d{2})[,.](\d{3})$`)
+
+	// subtitleTagRe matches the markup of cue text, e.g. "<i>", "<c.yellow>", "<00:01.000>" or "{\an8}", which
+	// takes no room on screen.
+	subtitleTagRe = regexp.MustCompile(`<[^>]*>|\{\\[^}]*\}`)
+)
+
+// ParseSubtitles reads an SRT or a WebVTT file, as told by the WEBVTT signature.
+func ParseSubtitles(r io.Reader) (*Subtitles, error) {
+	blocks, err := readSubtitleBlocks(r)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Subtitles{}
+
+	if len(blocks) > 0 && strings.HasPrefix(blocks[0].lines[0], "WEBVTT") {
+		signature := blocks[0].lines[0]
+		if rest := signature[len("WEBVTT"):]; rest != "" && rest[0] != ' ' && rest[0] != '\t' {
+			return nil, fmt.Errorf("%w: line 1: invalid WebVTT signature", ErrInvalidSubtitles)
+		}
+
+		s.Format = WebVTT
+		s.Header = strings.Join(append([]string{signature[len("WEBVTT"):]}, blocks[0].lines[1:]...), "\n")
+		blocks = blocks[1:]
+	}
+
+	for _, block := range blocks {
+		if s.Format == WebVTT {
+			keyword, _, _ := strings.Cut(block.lines[0], " ")
+			if keyword == "NOTE" || keyword == "STYLE" || keyword == "REGION" {
+				s.Cues = append(s.Cues, &Cue{Block: strings.Join(block.lines, "\n")})
+				continue
+			}
+		}
+
+		cue, err := parseCue(block.lines)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidSubtitles, block.line, err)
+		}
+
+		s.Cues = append(s.Cues, cue)
+	}
+
+	return s, nil
+}
+
+type subtitleBlock struct {
+	line  int // The line number of the first line.
+	lines []string
+}
+
+// readSubtitleBlocks splits the input at blank lines.
+func readSubtitleBlocks(r io.Reader) ([]subtitleBlock, error) {
+	var (
+		blocks []subtitleBlock
+		block  *subtitleBlock
+	)
+
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if lineNumber == 1 {
+			line = strings.TrimPrefix(line, "\uFEFF")
+		}
+
+		if strings.TrimSpace(line) == "" {
+			block = nil
+			continue
+		}
+
+		if block == nil {
+			blocks = append(blocks, subtitleBlock{line: lineNumber})
+			block = &blocks[len(blocks)-1]
+		}
+
+		block.lines = append(block.lines, line)
+	}
+
+	return blocks, scanner.Err()
+}
+
+func parseCue(lines []string) (*Cue, error) {
+	cue := &Cue{}
+
+	if !strings.Contains(lines[0], "-->") {
+		cue.ID, lines = lines[0], lines[1:]
+	}
+
+	if len(lines) == 0 {
+		return nil, errors.New("missing cue timings")
+	}
+
+	timing := subtitleTimingRe.FindStringSubmatch(lines[0])
+	if timing == nil {
+		return nil, fmt.Errorf("invalid cue timings %q", lines[0])
+	}
+
+	var err error
+	if cue.Start, err = parseSubtitleTimestamp(timing[1]); err != nil {
+		return nil, err
+	}
+	if cue.End, err = parseSubtitleTimestamp(timing[2]); err != nil {
+		return nil, err
+	}
+
+	cue.Settings = timing[3]
+	cue.Lines = lines[1:]
+
+	return cue, nil
+}
+
+func parseSubtitleTimestamp(s string) (time.Duration, error) {
+	m := subtitleTimestampRe.FindStringSubmatch(s)
+	if m == nil {
+		return 0, fmt.Errorf("invalid timestamp %q", s)
+	}
+
+	var parts [4]int
+	for i, part := range m[1:] {
+		if part != "" {
+			parts[i], _ = strconv.Atoi(part)
+		}
+	}
+
+	if parts[1] > 59 || parts[2] > 59 {
+		return 0, fmt.Errorf("invalid timestamp %q", s)
+	}
+
+	return time.Duration(parts[0])*time.Hour + time.Duration(parts[1])*time.Minute +
+		time.Duration(parts[2])*time.Second + time.Duration(parts[3])*time.Millisecond, nil
+}
+
+func formatSubtitleTimestamp(d time.Duration, format SubtitleFormat) string {
+	separator := ','
+	if format == WebVTT {
+		separator = '.'
+	}
+
+	ms := d.Milliseconds()
+
+	return fmt.Sprintf("%02d:%02d:%02d%c%03d", ms/3600000, ms/60000%60, ms/1000%60, separator, ms%1000)
+}
+
+// Write writes the subtitles in their Format. SRT cues without an ID are numbered by their position.
+func (s *Subtitles) Write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	if s.Format == WebVTT {
+		bw.WriteString("WEBVTT" + s.Header + "\n\n")
+	}
+
+	for i, cue := range s.Cues {
+		if cue.Block != "" {
+			bw.WriteString(cue.Block + "\n\n")
+			continue
+		}
+
+		id := cue.ID
+		if id == "" && s.Format == SRT {
+			id = strconv.Itoa(i + 1)
+		}
+		if id != "" {
+			bw.WriteString(id + "\n")
+		}
+
+		bw.WriteString(formatSubtitleTimestamp(cue.Start, s.Format) + " --> " + formatSubtitleTimestamp(cue.End, s.Format))
+		if cue.Settings != "" {
+			bw.WriteString(" " + cue.Settings)
+		}
+		bw.WriteString("\n")
+
+		for _, line := range cue.Lines {
+			bw.WriteString(line + "\n")
+		}
+		bw.WriteString("\n")
+	}
+
+	return bw.Flush()
+}
+
+// WrapSubtitles re-wraps the cues of the SRT or WebVTT file read from r to maxLineLength characters per line,
+// with a new Hyphenator for o, and writes the file to w.
+func WrapSubtitles(r io.Reader, w io.Writer, maxLineLength int, o Options) error {
+	h, err := NewHyphenator(o)
+	if err != nil {
+		return err
+	}
+
+	s, err := ParseSubtitles(r)
+	if err != nil {
+		return err
+	}
+
+	h.WrapSubtitles(s, maxLineLength)
+
+	return s.Write(w)
+}
+
+// WrapSubtitles re-wraps the lines of every cue of s, as WrapLines does.
+func (h *Hyphenator) WrapSubtitles(s *Subtitles, maxLineLength int) {
+	for _, cue := range s.Cues {
+		if cue.Block == "" {
+			cue.Lines = h.WrapLines(cue.Lines, maxLineLength)
+		}
+	}
+}
+
+// WrapLines re-wraps cue text to lines of up to maxLineLength characters, not counting markup. Lines are
+// broken between words where possible. Words longer than a line are split at the break points of h, with a
+// hyphen, or after a hyphen of their own; a syllable that does not fit on a line of its own overflows it.
+// Dialogue lines, i.e. those starting with "-", are kept on lines of their own.
+func (h *Hyphenator) WrapLines(lines []string, maxLineLength int) []string {
+	var (
+		wrapped   []string
+		paragraph []string
+	)
+
+	for i, line := range lines {
+		if i > 0 && strings.HasPrefix(strings.TrimSpace(line), "-") {
+			wrapped = append(wrapped, h.wrapWords(paragraph, maxLineLength)...)
+			paragraph = nil
+		}
+
+		paragraph = append(paragraph, strings.Fields(line)...)
+	}
+
+	return append(wrapped, h.wrapWords(paragraph, maxLineLength)...)
+}
+
+func (h *Hyphenator) wrapWords(words []string, maxLineLength int) []string {
+	var (
+		lines      []string
+		line       string
+		lineLength int
+	)
+
+	for _, word := range words {
+		wordLength := cueTextLength(word)
+
+		if line != "" && lineLength+1+wordLength <= maxLineLength {
+			line, lineLength = line+" "+word, lineLength+1+wordLength
+			continue
+		}
+
+		if wordLength <= maxLineLength || subtitleTagRe.MatchString(word) {
+			if line != "" {
+				lines = append(lines, line)
+			}
+			line, lineLength = word, wordLength
+			continue
+		}
+
+		// The word is longer than a line: fill the rest of the current line with its first syllables, and
+		// the lines that follow with the others.
+		for word != "" {
+			available := maxLineLength
+			if line != "" {
+				available -= lineLength + 1
+			}
+
+			head, tail := h.splitWord(word, available, false)
+			if head == "" && line == "" {
+				head, tail = h.splitWord(word, 0, true)
+			}
+
+			if head != "" {
+				if line != "" {
+					line += " "
+				}
+				line, word = line+head, tail
+			}
+
+			if word != "" {
+				lines = append(lines, line)
+				line = ""
+			}
+		}
+		lineLength = cueTextLength(line)
+	}
+
+	if line != "" {
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// splitWord splits word at its last break point that leaves a head, including the hyphen that is added,
+// of up to available characters. The whole word is returned as head if it fits, and an empty head if no
+// break fits. If first is set, available is ignored, and word is split at its first break point.
+func (h *Hyphenator) splitWord(word string, available int, first bool) (head, tail string) {
+	if !first && cueTextLength(word) <= available {
+		return word, ""
+	}
+
+	var breaks []int
+	for _, b := range h.BreakPoints(word) {
+		breaks = append(breaks, b.Position)
+	}
+	for i := 0; i < len(word)-1; i++ {
+		if word[i] == '-' && i > 0 {
+			breaks = append(breaks, i+1)
+		}
+	}
+
+	split := -1
+	for _, position := range breaks {
+		head := word[:position]
+		if word[position-1] != '-' {
+			head += "-"
+		}
+
+		if first {
+			if split == -1 || position < split {
+				split = position
+			}
+		} else if cueTextLength(head) <= available && position > split {
+			split = position
+		}
+	}
+
+	if split == -1 {
+		if first {
+			return word, ""
+		}
+		return "", word
+	}
+
+	head = word[:split]
+	if word[split-1] != '-' {
+		head += "-"
+	}
+
+	return head, word[split:]
+}
+
+// cueTextLength counts the characters of cue text that take room on screen.
+func cueTextLength(s string) int {
+	return letterCount(subtitleTagRe.ReplaceAllString(s, ""))
+}
@@ -0,0 +1,86 @@
+package grhyph
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWrapLines(t *testing.T) {
+	tests := []struct {
+		lines   []string
+		wrapped []string
+	}{
+		{[]string{"Καλημέρα σε όλους εσάς", "που βλέπετε"}, []string{"Καλημέρα", "σε όλους", "εσάς που", "βλέπετε"}},
+		{[]string{"- Ναι;", "- Όχι, ευχαριστώ."}, []string{"- Ναι;", "- Όχι,", "ευχαριστώ."}},
+		{[]string{"Η αντισυνταγματικότητα"}, []string{"Η αντισυ-", "νταγματι-", "κότητα"}},
+		{[]string{"<i>Καλημέρα</i> σε όλους"}, []string{"<i>Καλημέρα</i>", "σε όλους"}},
+		{[]string{"ένα-δυο-τρία-τέσσερα"}, []string{"ένα-δυο-", "τρία-τέσ-", "σερα"}},
+	}
+
+	hyphenationOptions := GetDefaultOptions()
+	hyphenationOptions.Separator = "-"
+
+	h, err := NewHyphenator(hyphenationOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range tests {
+		if wrapped := h.WrapLines(test.lines, 10); !reflect.DeepEqual(wrapped, test.wrapped) {
+			t.Errorf("(%q) Wrapped lines do not match: expected %q, got %q", test.lines, test.wrapped, wrapped)
+		}
+	}
+
+	// A full line is not given the first syllable of a long word.
+	lines, expected := []string{"καλημέρα παραλληλεπίπεδο"}, []string{"καλημέρα", "παραλλη-", "λεπίπεδο"}
+	if wrapped := h.WrapLines(lines, 8); !reflect.DeepEqual(wrapped, expected) {
+		t.Errorf("(%q) Wrapped lines do not match: expected %q, got %q", lines, expected, wrapped)
+	}
+}
+
+func TestWrapSubtitles(t *testing.T) {
+	tests := []hyphenationTest{
+		{
+			"\uFEFF1\r\n00:00:01,000 --> 00:00:02,500 X1:10 X2:20\r\nΚαλημέρα σε όλους\r\n\r\n2\r\n01:00:00,001 --> 01:00:02,000\r\nΚαλημέρα\r\n",
+			"1\n00:00:01,000 --> 00:00:02,500 X1:10 X2:20\nΚαλημέρα\nσε όλους\n\n2\n01:00:00,001 --> 01:00:02,000\nΚαλημέρα\n\n",
+		},
+		{
+			"WEBVTT - Καλημέρα\nKind: captions\n\nSTYLE\n::cue { color: yellow }\n\nNOTE Καλημέρα σε όλους\n\nc1\n00:01.000 --> 00:02.500 align:start line:0\nΚαλημέρα σε όλους\n\n00:03.000 --> 00:04.000\nσε όλους\n",
+			"WEBVTT - Καλημέρα\nKind: captions\n\nSTYLE\n::cue { color: yellow }\n\nNOTE Καλημέρα σε όλους\n\nc1\n00:00:01.000 --> 00:00:02.500 align:start line:0\nΚαλημέρα\nσε όλους\n\n00:00:03.000 --> 00:00:04.000\nσε όλους\n\n",
+		},
+	}
+
+	for _, test := range tests {
+		var b bytes.Buffer
+		if err := WrapSubtitles(strings.NewReader(test.input), &b, 10, GetDefaultOptions()); err != nil {
+			t.Fatal(err)
+		}
+
+		if b.String() != test.hyphenated {
+			t.Errorf("(%q) Wrapped value does not match: expected %q, got %q", test.input, test.hyphenated, b.String())
+		}
+	}
+}
+
+func TestParseSubtitles(t *testing.T) {
+	s, err := ParseSubtitles(strings.NewReader("WEBVTT\n\nid\n1:02:03.004 --> 1:02:04.000\nκαλημέρα\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &Cue{ID: "id", Start: time.Hour + 2*time.Minute + 3*time.Second + 4*time.Millisecond,
+		End: time.Hour + 2*time.Minute + 4*time.Second, Lines: []string{"καλημέρα"}}
+	if s.Format != WebVTT || len(s.Cues) != 1 || !reflect.DeepEqual(s.Cues[0], expected) {
+		t.Errorf("Cues do not match: expected %+v, got %+v", expected, s.Cues)
+	}
+
+	for _, invalid := range []string{"WEBVTTX\n", "1\n00:01,000 -> 00:02,000\nα\n", "1\n00:61,000 --> 00:62,000\nα\n", "1\n"} {
+		if _, err := ParseSubtitles(strings.NewReader(invalid)); !errors.Is(err, ErrInvalidSubtitles) {
+			t.Errorf("(%q) Expected ErrInvalidSubtitles, got %v", invalid, err)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package grhyph
+
+import (
+	"unicode/utf8"
+)
+
+// Syllable is a single syllable of a word, located by its offsets into the syllabified input.
+type Syllable struct {
+	Text string `json:"text"`
+
+	// Start and End are byte offsets, RuneStart and RuneEnd are rune offsets into the input.
+	Start     int `json:"start"`
+	End       int `json:"end"`
+	RuneStart int `json:"rune_start"`
+	RuneEnd   int `json:"rune_end"`
+
+	// Word is the word the syllable belongs to, and WordIndex its position among the input's words.
+	Word      string `json:"word"`
+	WordIndex int    `json:"word_index"`
+
+	// SpeechSounds are the speech sounds that make up Text. A speech sound that a rule splits in two,
+	// e.g. the "ai" of "a-i-di-niw-tis", is clipped to the part that lies within the syllable.
+	SpeechSounds []SpeechSound `json:"speech_sounds"`
+}
+
+// Syllabify splits the words of s into syllables, at the points where Hyphenate would insert the separator.
+// Punctuation and whitespace, which separate words, are not part of any syllable.
+func (h *Hyphenator) Syllabify(s string) []Syllable {
+	breaks := map[int]bool{}
+	for _, b := range h.BreakPoints(s) {
+		breaks[b.Position] = true
+	}
+
+	speechSounds, _ := stringTospeechSounds(s)
+
+	var (
+		syllables     []Syllable
+		wordIndex     = -1
+		offset        int
+		runeOffset    int
+		word          []SpeechSound
+		wordStart     int
+		wordRuneStart int
+	)
+
+	flushWord := func() {
+		if len(word) == 0 {
+			return
+		}
+
+		wordIndex++
+		syllables = append(syllables, splitWord(s, word, wordStart, wordRuneStart, wordIndex, breaks)...)
+		word = nil
+	}
+
+	for _, speechSound := range speechSounds {
+		if speechSound.Group == "punctuation" {
+			flushWord()
+		} else {
+			if len(word) == 0 {
+				wordStart, wordRuneStart = offset, runeOffset
+			}
+			word = append(word, speechSound)
+		}
+
+		offset += len(speechSound.Match)
+		runeOffset += utf8.RuneCountInString(speechSound.Match)
+	}
+	flushWord()
+
+	return syllables
+}
+
+// splitWord splits the speech sounds of a single word, that starts at byte offset start (rune offset
+// runeStart) of s, at breaks.
+func splitWord(s string, word []SpeechSound, start, runeStart, wordIndex int, breaks map[int]bool) []Syllable {
+	end := start
+	for _, speechSound := range word {
+		end += len(speechSound.Match)
+	}
+
+	var syllables []Syllable
+
+	syllableStart := start
+	for i := start + 1; i <= end; i++ {
+		if i != end && !breaks[i] {
+			continue
+		}
+
+		runeEnd := runeStart + utf8.RuneCountInString(s[syllableStart:i])
+		syllables = append(syllables, Syllable{
+			Text:         s[syllableStart:i],
+			Start:        syllableStart,
+			End:          i,
+			RuneStart:    runeStart,
+			RuneEnd:      runeEnd,
+			Word:         s[start:end],
+			WordIndex:    wordIndex,
+			SpeechSounds: clipSpeechSounds(word, start, syllableStart, i),
+		})
+		syllableStart, runeStart = i, runeEnd
+	}
+
+	return syllables
+}
+
+// clipSpeechSounds returns the parts of the speech sounds, starting at byte offset offset, within [from, to).
+func clipSpeechSounds(speechSounds []SpeechSound, offset, from, to int) []SpeechSound {
+	var clipped []SpeechSound
+
+	for _, speechSound := range speechSounds {
+		soundStart, soundEnd := offset, offset+len(speechSound.Match)
+		offset = soundEnd
+
+		if soundEnd <= from || soundStart >= to {
+			continue
+		}
+
+		if soundStart < from {
+			speechSound.Match = speechSound.Match[from-soundStart:]
+		}
+		if soundEnd > to {
+			speechSound.Match = speechSound.Match[:len(speechSound.Match)-(soundEnd-to)]
+		}
+
+		clipped = append(clipped, speechSound)
+	}
+
+	return clipped
+}
@@ -0,0 +1,77 @@
+package grhyph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSyllabify(t *testing.T) {
+	hyphenationOptions := GetDefaultOptions()
+
+	// The separator also occurs within the text, which must not affect the syllables.
+	hyphenationOptions.Separator = "-"
+
+	h, err := NewHyphenator(hyphenationOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	syllables := h.Syllabify("Μία-και δύο")
+
+	var texts []string
+	for _, syllable := range syllables {
+		texts = append(texts, syllable.Text)
+	}
+
+	if expected := []string{"Μί", "α", "και", "δύ", "ο"}; !reflect.DeepEqual(texts, expected) {
+		t.Errorf("Syllables do not match: expected %v, got %v", expected, texts)
+	}
+
+	expected := Syllable{
+		Text:      "δύ",
+		Start:     14,
+		End:       18,
+		RuneStart: 8,
+		RuneEnd:   10,
+		Word:      "δύο",
+		WordIndex: 2,
+		SpeechSounds: []SpeechSound{
+			{Match: "δ", Group: "consonants", EventualVowelsExist: true, ImmediateVowelExists: true},
+			{Match: "ύ", Group: "vowels", EventualVowelsExist: true, ImmediateVowelExists: true},
+		},
+	}
+
+	if !reflect.DeepEqual(syllables[3], expected) {
+		t.Errorf("Syllable does not match: expected %+v, got %+v", expected, syllables[3])
+	}
+}
+
+func TestSyllabifyRules(t *testing.T) {
+	hyphenationOptions := GetDefaultOptions()
+
+	hyphenationOptions.UseGrhyphRules = true
+
+	h, err := NewHyphenator(hyphenationOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The rules split the "ai" speech sound in two.
+	syllables := h.Syllabify("aidiniwtis")
+
+	var texts []string
+	for _, syllable := range syllables {
+		texts = append(texts, syllable.Text)
+
+		if syllable.Word != "aidiniwtis" {
+			t.Errorf("(%s) Word does not match: expected aidiniwtis, got %s", syllable.Text, syllable.Word)
+		}
+		if joined := speechSoundJoin(syllable.SpeechSounds); joined != syllable.Text {
+			t.Errorf("(%s) Speech sounds do not match the syllable text, got %s", syllable.Text, joined)
+		}
+	}
+
+	if expected := []string{"a", "i", "di", "niw", "tis"}; !reflect.DeepEqual(texts, expected) {
+		t.Errorf("Syllables do not match: expected %v, got %v", expected, texts)
+	}
+}
@@ -0,0 +1,119 @@
+package grhyph
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/text/transform"
+)
+
+// maxTransformedWordLength bounds, in bytes, the words that a Transformer hyphenates. Longer runs of
+// letters cannot be held back within the buffers of transform.Reader and transform.Chain, and are passed
+// through unhyphenated.
+const maxTransformedWordLength = 256
+
+// Transformer is a transform.SpanningTransformer that hyphenates text, one word at a time. Incomplete words
+// at the end of src are held back until the rest of the word, or atEOF, arrives.
+type Transformer struct {
+	h *Hyphenator
+
+	passThrough bool // Within a word longer than maxTransformedWordLength.
+}
+
+var _ transform.SpanningTransformer = (*Transformer)(nil)
+
+// NewTransformer returns a Transformer that hyphenates with a new Hyphenator for o.
+func NewTransformer(o Options) (*Transformer, error) {
+	h, err := NewHyphenator(o)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.Transformer(), nil
+}
+
+// Transformer returns a Transformer that hyphenates with h.
+func (h *Hyphenator) Transformer() *Transformer {
+	return &Transformer{h: h}
+}
+
+func (t *Transformer) Reset() {
+	t.passThrough = false
+}
+
+// nextWord returns the end of the word that starts at src[start:], and the size of the word boundary rune
+// that follows it; size is 0 when the word runs to the end of src, or to an incomplete rune.
+func nextWord(src []byte, start int, atEOF bool) (end, size int) {
+	for end = start; end < len(src); end += size {
+		if !utf8.FullRune(src[end:]) {
+			if atEOF {
+				return len(src), 0
+			}
+			return end, 0
+		}
+
+		var r rune
+		r, size = utf8.DecodeRune(src[end:])
+		if isWordBoundary(r) {
+			return end, size
+		}
+	}
+
+	return end, 0
+}
+
+func (t *Transformer) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		end, size := nextWord(src, nSrc, atEOF)
+		complete := size > 0 || (atEOF && end == len(src))
+
+		var word []byte
+		switch {
+		case t.passThrough:
+			word = src[nSrc:end]
+		case !complete && (nSrc > 0 || end-nSrc < maxTransformedWordLength):
+			return nDst, nSrc, transform.ErrShortSrc
+		case !complete:
+			t.passThrough = true
+			word = src[nSrc:end]
+		default:
+			word = []byte(t.h.Hyphenate(string(src[nSrc:end])))
+		}
+
+		if nDst+len(word)+size > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+
+		nDst += copy(dst[nDst:], word)
+		nDst += copy(dst[nDst:], src[end:end+size])
+		nSrc = end + size
+
+		if complete {
+			t.passThrough = false
+		} else if end == nSrc && nSrc < len(src) {
+			// Passing through, up to an incomplete rune.
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+	}
+
+	return nDst, nSrc, nil
+}
+
+// Span returns the length of the prefix of src that hyphenation leaves unchanged, i.e. the words without
+// any break.
+func (t *Transformer) Span(src []byte, atEOF bool) (n int, err error) {
+	for n < len(src) {
+		end, size := nextWord(src, n, atEOF)
+		if size == 0 && !(atEOF && end == len(src)) {
+			return n, transform.ErrShortSrc
+		}
+
+		word := string(src[n:end])
+		if t.h.Hyphenate(word) != word {
+			return n, transform.ErrEndOfSpan
+		}
+
+		n = end + size
+	}
+
+	return n, nil
+}
@@ -0,0 +1,81 @@
+package grhyph
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/transform"
+)
+
+func TestTransformer(t *testing.T) {
+	hyphenationOptions := GetDefaultOptions()
+
+	hyphenationOptions.Separator = "-"
+	hyphenationOptions.UseGrhyphRules = true
+
+	h, err := NewHyphenator(hyphenationOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	text := strings.Repeat("Μια και δυο. Μία και δύο, «αηδόνια» — aidiniwtis/anthia; παλιόπαλιοpalio\n", 40)
+
+	transformed, _, err := transform.String(h.Transformer(), text)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := h.Hyphenate(text); transformed != expected {
+		t.Errorf("Transformed value does not match the hyphenated text")
+	}
+
+	// transform.Reader feeds the text in chunks, so that words are split across Transform calls.
+	b, err := io.ReadAll(transform.NewReader(strings.NewReader(text), transform.Chain(h.Transformer())))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := h.Hyphenate(text); string(b) != expected {
+		t.Errorf("Transformed value does not match the hyphenated text")
+	}
+
+	// Words that exceed the buffer of transform.Reader are passed through.
+	hyphenationOptions.UseGrhyphRules = false
+
+	h, err = NewHyphenator(hyphenationOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	longWord := strings.Repeat("πα", 4096)
+	b, err = io.ReadAll(transform.NewReader(strings.NewReader(longWord+" πάλι"), h.Transformer()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := longWord + " πά-λι"; string(b) != expected {
+		t.Errorf("Transformed value does not match: expected the long word to pass through, got %s", b)
+	}
+}
+
+func TestTransformerSpan(t *testing.T) {
+	hyphenationOptions := GetDefaultOptions()
+
+	hyphenationOptions.Separator = "-"
+
+	h, err := NewHyphenator(hyphenationOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n, err := h.Transformer().Span([]byte("και μπρος, να αηδόνια"), true); n != len("και μπρος, να ") ||
+		err != transform.ErrEndOfSpan {
+		t.Errorf("Expected the span to end before αηδόνια, got %d, %v", n, err)
+	}
+
+	if n, err := h.Transformer().Span([]byte("και να αη"), false); n != len("και να ") ||
+		err != transform.ErrShortSrc {
+		t.Errorf("Expected a short source after the complete words, got %d, %v", n, err)
+	}
+}